@@ -0,0 +1,79 @@
+package admin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// claims is the payload of the symmetric-signed JWTs this API accepts. Only
+// the HS256 algorithm is supported, matching ADMIN_JWT_SECRET being a plain
+// shared secret rather than a keypair.
+type claims struct {
+	Rights map[string][]string `json:"rights"` // method -> allowed paths (exact or "prefix/*")
+	Exp    int64               `json:"exp,omitempty"`
+}
+
+// allows reports whether the claims permit method on path. A right of
+// "/v1/workday/*" matches any path under "/v1/workday/".
+func (c claims) allows(method, path string) bool {
+	for _, allowed := range c.Rights[method] {
+		if allowed == path {
+			return true
+		}
+		if strings.HasSuffix(allowed, "/*") && strings.HasPrefix(path, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyJWT validates an HS256 JWT's signature and expiry and returns its
+// claims.
+func verifyJWT(token, secret string) (claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims{}, errors.New("admin: malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	headerRaw, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return claims{}, errors.New("admin: malformed header")
+	}
+	if err := json.Unmarshal(headerRaw, &header); err != nil {
+		return claims{}, errors.New("admin: malformed header")
+	}
+	if header.Alg != "HS256" {
+		return claims{}, errors.New("admin: unsupported alg " + header.Alg)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return claims{}, errors.New("admin: malformed signature")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return claims{}, errors.New("admin: invalid signature")
+	}
+
+	payloadRaw, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return claims{}, errors.New("admin: malformed payload")
+	}
+	var c claims
+	if err := json.Unmarshal(payloadRaw, &c); err != nil {
+		return claims{}, errors.New("admin: malformed claims")
+	}
+	if c.Exp != 0 && time.Now().Unix() > c.Exp {
+		return claims{}, errors.New("admin: token expired")
+	}
+	return c, nil
+}
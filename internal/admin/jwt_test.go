@@ -0,0 +1,104 @@
+package admin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// signToken builds an HS256 JWT for secret and payload, mirroring the
+// encoding verifyJWT expects, so tests don't depend on an external JWT
+// library to produce fixtures.
+func signToken(t *testing.T, secret string, header, payload any) string {
+	t.Helper()
+	headerRaw, err := json.Marshal(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payloadRaw, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(headerRaw) + "." + base64.RawURLEncoding.EncodeToString(payloadRaw)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+	sig := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	return signingInput + "." + sig
+}
+
+func TestVerifyJWTValid(t *testing.T) {
+	token := signToken(t, "shh",
+		map[string]string{"alg": "HS256", "typ": "JWT"},
+		map[string]any{"rights": map[string][]string{"GET": {"/v1/workday/*"}}},
+	)
+	c, err := verifyJWT(token, "shh")
+	if err != nil {
+		t.Fatalf("verifyJWT: unexpected error: %v", err)
+	}
+	if !c.allows("GET", "/v1/workday/2026-07-29") {
+		t.Error("expected claims to allow GET /v1/workday/2026-07-29")
+	}
+}
+
+func TestVerifyJWTWrongSignature(t *testing.T) {
+	token := signToken(t, "shh",
+		map[string]string{"alg": "HS256", "typ": "JWT"},
+		map[string]any{"rights": map[string][]string{}},
+	)
+	if _, err := verifyJWT(token, "wrong-secret"); err == nil {
+		t.Error("expected error for wrong secret, got nil")
+	}
+}
+
+func TestVerifyJWTUnsupportedAlg(t *testing.T) {
+	token := signToken(t, "shh",
+		map[string]string{"alg": "RS256", "typ": "JWT"},
+		map[string]any{"rights": map[string][]string{}},
+	)
+	if _, err := verifyJWT(token, "shh"); err == nil {
+		t.Error("expected error for unsupported alg, got nil")
+	}
+}
+
+func TestVerifyJWTExpired(t *testing.T) {
+	token := signToken(t, "shh",
+		map[string]string{"alg": "HS256", "typ": "JWT"},
+		map[string]any{"rights": map[string][]string{}, "exp": time.Now().Add(-time.Hour).Unix()},
+	)
+	if _, err := verifyJWT(token, "shh"); err == nil {
+		t.Error("expected error for expired token, got nil")
+	}
+}
+
+func TestVerifyJWTMalformed(t *testing.T) {
+	if _, err := verifyJWT("not-a-jwt", "shh"); err == nil {
+		t.Error("expected error for malformed token, got nil")
+	}
+}
+
+func TestClaimsAllows(t *testing.T) {
+	c := claims{Rights: map[string][]string{
+		"GET":  {"/v1/workday/*"},
+		"POST": {"/v1/runs"},
+	}}
+
+	cases := []struct {
+		method, path string
+		want         bool
+	}{
+		{"POST", "/v1/runs", true},
+		{"POST", "/v1/runs/extra", false},
+		{"GET", "/v1/workday/2026-07-29", true},
+		{"GET", "/v1/workday/", true},
+		{"GET", "/v1/runs", false},
+		{"DELETE", "/v1/runs", false},
+	}
+	for _, tc := range cases {
+		if got := c.allows(tc.method, tc.path); got != tc.want {
+			t.Errorf("allows(%q, %q) = %v, want %v", tc.method, tc.path, got, tc.want)
+		}
+	}
+}
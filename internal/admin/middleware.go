@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"net/http"
+	"strings"
+)
+
+// withAuth rejects any request without a valid bearer JWT whose `rights`
+// claim permits the request's method and path. /healthz is mounted outside
+// this middleware since it carries no sensitive information.
+func withAuth(secret string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(authHeader, "Bearer ")
+		if token == "" || token == authHeader {
+			writeJSONError(w, http.StatusUnauthorized, "missing bearer token")
+			return
+		}
+
+		c, err := verifyJWT(token, secret)
+		if err != nil {
+			writeJSONError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if !c.allows(r.Method, r.URL.Path) {
+			writeJSONError(w, http.StatusForbidden, "token does not permit "+r.Method+" "+r.URL.Path)
+			return
+		}
+
+		next(w, r)
+	}
+}
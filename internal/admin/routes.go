@@ -0,0 +1,127 @@
+package admin
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+func handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleRuns serves POST /v1/runs (trigger) and GET /v1/runs (list recent
+// run-log entries) on the same path, dispatching on method.
+func handleRuns(deps Dependencies) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			handleTriggerRun(deps, w, r)
+		case http.MethodGet:
+			handleListRuns(deps, w, r)
+		default:
+			writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	}
+}
+
+func handleTriggerRun(deps Dependencies, w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	force := q.Get("force") == "true"
+
+	var datePtr *time.Time
+	if dateStr := q.Get("date"); dateStr != "" {
+		d, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid date, expected YYYY-MM-DD")
+			return
+		}
+		datePtr = &d
+	}
+
+	issueURL, created, err := deps.Run(r.Context(), datePtr, force)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issue_url": issueURL,
+		"created":   created,
+	})
+}
+
+// runLogEntry is one parsed line of the RUN_LOG_FILE, written as
+// "STATUS <RFC3339 time>: <detail>".
+type runLogEntry struct {
+	Status string `json:"status"`
+	Time   string `json:"time"`
+	Detail string `json:"detail"`
+}
+
+func handleListRuns(deps Dependencies, w http.ResponseWriter, r *http.Request) {
+	path := deps.RunLogPath()
+	f, err := os.Open(path)
+	if err != nil {
+		writeJSON(w, http.StatusOK, []runLogEntry{})
+		return
+	}
+	defer f.Close()
+
+	var entries []runLogEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if entry, ok := parseRunLogLine(scanner.Text()); ok {
+			entries = append(entries, entry)
+		}
+	}
+	writeJSON(w, http.StatusOK, entries)
+}
+
+func parseRunLogLine(line string) (runLogEntry, bool) {
+	fields := strings.SplitN(line, " ", 2)
+	if len(fields) != 2 {
+		return runLogEntry{}, false
+	}
+	rest := strings.SplitN(fields[1], ": ", 2)
+	if len(rest) != 2 {
+		return runLogEntry{}, false
+	}
+	return runLogEntry{Status: fields[0], Time: rest[0], Detail: rest[1]}, true
+}
+
+func handleWorkday(deps Dependencies) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		dateStr := strings.TrimPrefix(r.URL.Path, "/v1/workday/")
+		date, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid date, expected YYYY-MM-DD")
+			return
+		}
+		isWorkday, err := deps.IsWorkday(r.Context(), date)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"date":    dateStr,
+			"workday": isWorkday,
+		})
+	}
+}
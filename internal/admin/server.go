@@ -0,0 +1,52 @@
+// Package admin exposes a small opt-in HTTP API (ADMIN_LISTEN) for
+// triggering a report run and inspecting status without restarting the
+// process, authenticated via a symmetric-signed JWT (ADMIN_JWT_SECRET).
+package admin
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RunFunc triggers one report run. date, if non-nil, pins the report date
+// instead of using the previous workday; force bypasses the workday check.
+type RunFunc func(ctx context.Context, date *time.Time, force bool) (issueURL string, created bool, err error)
+
+// Dependencies wires the admin API to the rest of the program.
+type Dependencies struct {
+	Run        RunFunc
+	RunLogPath func() string
+	IsWorkday  func(ctx context.Context, date time.Time) (bool, error)
+}
+
+// Server is the embedded admin HTTP server.
+type Server struct {
+	httpServer *http.Server
+}
+
+// New builds a Server listening on addr, authenticating every route except
+// /healthz with jwtSecret.
+func New(addr, jwtSecret string, deps Dependencies) *Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", handleHealthz)
+	mux.HandleFunc("/v1/runs", withAuth(jwtSecret, handleRuns(deps)))
+	mux.HandleFunc("/v1/workday/", withAuth(jwtSecret, handleWorkday(deps)))
+
+	return &Server{httpServer: &http.Server{Addr: addr, Handler: mux}}
+}
+
+// ListenAndServe blocks serving the admin API until the server is closed or
+// an unrecoverable error occurs.
+func (s *Server) ListenAndServe() error {
+	err := s.httpServer.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// Shutdown gracefully stops the admin server.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,101 @@
+package issuetemplate
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const issueFormPath = ".github/ISSUE_TEMPLATE/daily.yml"
+
+// fetchIssueFormBody fetches the repo's GitHub issue form at issueFormPath
+// via the Contents API and extracts a usable default body template from it.
+func fetchIssueFormBody(ctx context.Context, token, owner, repo string) (string, error) {
+	endpoint := fmt.Sprintf("https://api.github.com/repos/%s/%s/contents/%s", owner, repo, issueFormPath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("issuetemplate: no issue form at %s", issueFormPath)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("issuetemplate: fetching issue form failed: %s", resp.Status)
+	}
+
+	var out struct {
+		Content  string `json:"content"`
+		Encoding string `json:"encoding"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	if out.Encoding != "base64" {
+		return "", fmt.Errorf("issuetemplate: unexpected content encoding %q", out.Encoding)
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(out.Content, "\n", ""))
+	if err != nil {
+		return "", err
+	}
+	return extractIssueFormBody(string(raw)), nil
+}
+
+// extractIssueFormBody pulls the default template text out of a GitHub issue
+// form YAML file. Issue forms are a list of fields under "body:", each with
+// an "attributes" block that may set a "value: |" block of default text;
+// this concatenates every such block in order, which is the closest
+// equivalent to the form's pre-filled content.
+//
+// This only understands the small subset of YAML issue forms actually use
+// (block scalars introduced by "value: |" or "value:|", indented by two
+// extra spaces) and
+// is not a general YAML parser.
+func extractIssueFormBody(raw string) string {
+	lines := strings.Split(raw, "\n")
+	var out []string
+	inValueBlock := false
+	var blockIndent int
+
+	for _, line := range lines {
+		trimmed := strings.TrimRight(line, "\r")
+		if inValueBlock {
+			indent := leadingSpaces(trimmed)
+			if strings.TrimSpace(trimmed) != "" && indent < blockIndent {
+				inValueBlock = false
+			} else {
+				out = append(out, strings.TrimPrefix(trimmed, strings.Repeat(" ", blockIndent)))
+				continue
+			}
+		}
+		afterColon := strings.TrimSpace(trimmed)
+		if strings.HasPrefix(afterColon, "value: |") || afterColon == "value:|" {
+			inValueBlock = true
+			blockIndent = leadingSpaces(trimmed) + 2
+			continue
+		}
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+func leadingSpaces(s string) int {
+	n := 0
+	for _, r := range s {
+		if r != ' ' {
+			break
+		}
+		n++
+	}
+	return n
+}
@@ -0,0 +1,101 @@
+package issuetemplate
+
+import "testing"
+
+func TestExtractIssueFormBodySingleBlock(t *testing.T) {
+	raw := `name: Daily Report
+body:
+  - type: textarea
+    id: progress
+    attributes:
+      label: 昨日进展
+      value: |
+        - 昨日进展：
+        - 今日计划：
+`
+	want := "- 昨日进展：\n- 今日计划："
+	if got := extractIssueFormBody(raw); got != want {
+		t.Errorf("extractIssueFormBody() = %q, want %q", got, want)
+	}
+}
+
+func TestExtractIssueFormBodyConcatenatesMultipleBlocksInOrder(t *testing.T) {
+	raw := `body:
+  - type: textarea
+    attributes:
+      label: 昨日进展
+      value: |
+        first block line
+  - type: textarea
+    attributes:
+      label: 今日计划
+      value: |
+        second block line
+`
+	want := "first block line\nsecond block line"
+	if got := extractIssueFormBody(raw); got != want {
+		t.Errorf("extractIssueFormBody() = %q, want %q", got, want)
+	}
+}
+
+func TestExtractIssueFormBodyStopsOnDedent(t *testing.T) {
+	raw := `body:
+  - type: textarea
+    attributes:
+      value: |
+        kept line
+    validations:
+      required: false
+`
+	want := "kept line"
+	if got := extractIssueFormBody(raw); got != want {
+		t.Errorf("extractIssueFormBody() = %q, want %q", got, want)
+	}
+}
+
+func TestExtractIssueFormBodyAcceptsCompactForm(t *testing.T) {
+	raw := `body:
+  - type: textarea
+    attributes:
+      value:|
+        compact form line
+`
+	want := "compact form line"
+	if got := extractIssueFormBody(raw); got != want {
+		t.Errorf("extractIssueFormBody() = %q, want %q", got, want)
+	}
+}
+
+func TestExtractIssueFormBodyNoValueBlocks(t *testing.T) {
+	raw := `name: Daily Report
+body:
+  - type: textarea
+    attributes:
+      label: 昨日进展
+`
+	if got := extractIssueFormBody(raw); got != "" {
+		t.Errorf("extractIssueFormBody() = %q, want empty string", got)
+	}
+}
+
+func TestExtractIssueFormBodyHandlesCRLFLineEndings(t *testing.T) {
+	raw := "body:\r\n  - attributes:\r\n      value: |\r\n        line one\r\n        line two\r\n"
+	want := "line one\nline two"
+	if got := extractIssueFormBody(raw); got != want {
+		t.Errorf("extractIssueFormBody() = %q, want %q", got, want)
+	}
+}
+
+func TestExtractIssueFormBodyPreservesBlankLinesWithinBlock(t *testing.T) {
+	raw := `body:
+  - attributes:
+      value: |
+        - 昨日进展：
+
+        - 今日计划：
+`
+	want := "- 昨日进展：\n\n- 今日计划："
+	if got := extractIssueFormBody(raw); got != want {
+		t.Errorf("extractIssueFormBody() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+// Package issuetemplate renders the daily report issue's title and body from
+// configurable Go text/template strings, replacing the previously hardcoded
+// "【YYYY-MM-DD】 <prefix>" title and "请在此填写：..." body.
+package issuetemplate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/zhengjinwei-ola/daily_issue/internal/reportsource"
+)
+
+const defaultTitleTemplate = `【{{.PrevWorkday}}】 {{.TitlePrefix}}`
+
+// defaultBodyTemplate renders collected ReportSource items, grouped into
+// sections, when any were collected; otherwise it falls back to the original
+// static bullet placeholder so a bot with no sources configured sees no
+// behavior change.
+const defaultBodyTemplate = `{{if .Items}}{{renderItems .Items}}{{else}}请在此填写：
+- 昨日进展：
+- 今日计划：
+- 风险/阻塞：{{end}}`
+
+// funcMap exposes reportsource.Render as {{renderItems}} so both the default
+// and any custom BODY_TEMPLATE can render collected items.
+var funcMap = template.FuncMap{
+	"renderItems": reportsource.Render,
+}
+
+// TemplateData is exposed to TITLE_TEMPLATE and BODY_TEMPLATE as the "."
+// context, plus whatever ReportSource results were collected.
+type TemplateData struct {
+	Date        string // today, "YYYY-MM-DD"
+	Weekday     string // today's Chinese weekday name, e.g. "周二"
+	PrevWorkday string // previous workday, "YYYY-MM-DD"
+	Author      string
+	TitlePrefix string
+	Items       []reportsource.ReportItem
+}
+
+// Templates holds the parsed title and body templates for one run.
+type Templates struct {
+	title *template.Template
+	body  *template.Template
+}
+
+// Load builds Templates from TITLE_TEMPLATE/BODY_TEMPLATE env vars. If
+// BODY_TEMPLATE is unset and the repo has an issue form at
+// .github/ISSUE_TEMPLATE/daily.yml, that form's body is fetched and used as
+// the default body template instead of the static bullet list.
+func Load(ctx context.Context, token, owner, repo string) (*Templates, error) {
+	titleSrc := envOrDefault("TITLE_TEMPLATE", defaultTitleTemplate)
+
+	bodySrc := os.Getenv("BODY_TEMPLATE")
+	if bodySrc == "" {
+		if formBody, err := fetchIssueFormBody(ctx, token, owner, repo); err == nil && strings.TrimSpace(formBody) != "" {
+			bodySrc = formBody
+		} else {
+			bodySrc = defaultBodyTemplate
+		}
+	}
+
+	title, err := template.New("title").Funcs(funcMap).Parse(titleSrc)
+	if err != nil {
+		return nil, fmt.Errorf("issuetemplate: parsing TITLE_TEMPLATE: %w", err)
+	}
+	body, err := template.New("body").Funcs(funcMap).Parse(bodySrc)
+	if err != nil {
+		return nil, fmt.Errorf("issuetemplate: parsing BODY_TEMPLATE: %w", err)
+	}
+	return &Templates{title: title, body: body}, nil
+}
+
+// Render executes both templates against data.
+func (t *Templates) Render(data TemplateData) (title, body string, err error) {
+	var titleBuf, bodyBuf strings.Builder
+	if err := t.title.Execute(&titleBuf, data); err != nil {
+		return "", "", fmt.Errorf("issuetemplate: rendering title: %w", err)
+	}
+	if err := t.body.Execute(&bodyBuf, data); err != nil {
+		return "", "", fmt.Errorf("issuetemplate: rendering body: %w", err)
+	}
+	return titleBuf.String(), bodyBuf.String(), nil
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,45 @@
+package issuetemplate
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// IssueOptions carries the non-text fields GitHub's create-issue API accepts
+// beyond title/body.
+type IssueOptions struct {
+	Labels    []string
+	Assignees []string
+	Milestone int // 0 means "no milestone"
+	Projects  []string
+}
+
+// IssueOptionsFromEnv reads ISSUE_LABELS, ISSUE_ASSIGNEES, ISSUE_MILESTONE,
+// and ISSUE_PROJECTS (all comma-separated except ISSUE_MILESTONE, which is a
+// milestone number).
+func IssueOptionsFromEnv() IssueOptions {
+	opts := IssueOptions{
+		Labels:    splitCSV(os.Getenv("ISSUE_LABELS")),
+		Assignees: splitCSV(os.Getenv("ISSUE_ASSIGNEES")),
+		Projects:  splitCSV(os.Getenv("ISSUE_PROJECTS")),
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("ISSUE_MILESTONE"))); err == nil {
+		opts.Milestone = n
+	}
+	return opts
+}
+
+func splitCSV(s string) []string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
@@ -0,0 +1,19 @@
+package notify
+
+import "context"
+
+// DiscordNotifier posts to a Discord channel webhook.
+type DiscordNotifier struct {
+	webhookURL string
+}
+
+func NewDiscordNotifier(webhookURL string) *DiscordNotifier {
+	return &DiscordNotifier{webhookURL: webhookURL}
+}
+
+func (d *DiscordNotifier) Name() string { return "discord" }
+
+func (d *DiscordNotifier) Send(ctx context.Context, event Event) error {
+	payload := map[string]any{"content": DefaultMessage(event)}
+	return postJSON(ctx, d.webhookURL, payload)
+}
@@ -0,0 +1,45 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+)
+
+// EmailNotifier sends the announcement as a plain-text email over SMTP.
+type EmailNotifier struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+// NewEmailNotifier builds an EmailNotifier. to is a comma-separated
+// recipient list.
+func NewEmailNotifier(host, port, username, password, from, to string) *EmailNotifier {
+	var recipients []string
+	for _, addr := range strings.Split(to, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			recipients = append(recipients, addr)
+		}
+	}
+	return &EmailNotifier{host: host, port: port, username: username, password: password, from: from, to: recipients}
+}
+
+func (e *EmailNotifier) Name() string { return "email" }
+
+func (e *EmailNotifier) Send(ctx context.Context, event Event) error {
+	if len(e.to) == 0 {
+		return fmt.Errorf("email notifier: no recipients configured")
+	}
+	subject := fmt.Sprintf("[日报机器人] %s", event.Kind)
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n",
+		e.from, strings.Join(e.to, ","), subject, DefaultMessage(event))
+
+	addr := e.host + ":" + e.port
+	auth := smtp.PlainAuth("", e.username, e.password, e.host)
+	return smtp.SendMail(addr, auth, e.from, e.to, []byte(msg))
+}
@@ -0,0 +1,35 @@
+package notify
+
+import "context"
+
+// LarkNotifier posts an interactive card to a Feishu/Lark custom bot webhook.
+type LarkNotifier struct {
+	webhookURL string
+}
+
+func NewLarkNotifier(webhookURL string) *LarkNotifier {
+	return &LarkNotifier{webhookURL: webhookURL}
+}
+
+func (l *LarkNotifier) Name() string { return "lark" }
+
+func (l *LarkNotifier) Send(ctx context.Context, event Event) error {
+	payload := map[string]any{
+		"msg_type": "interactive",
+		"card": map[string]any{
+			"elements": []map[string]any{
+				{
+					"tag": "div",
+					"text": map[string]any{
+						"tag":     "lark_md",
+						"content": DefaultMessage(event),
+					},
+				},
+			},
+			"header": map[string]any{
+				"title": map[string]any{"tag": "plain_text", "content": "日报机器人"},
+			},
+		},
+	}
+	return postJSON(ctx, l.webhookURL, payload)
+}
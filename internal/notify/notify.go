@@ -0,0 +1,126 @@
+// Package notify broadcasts daily-report run events to any number of
+// configured channels (Slack, Feishu/Lark, WeCom, Discord, email, or a
+// generic webhook), replacing the single hardcoded Slack call.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Kind identifies what happened during a run, used both to pick wording and
+// to filter which channels get notified via NOTIFY_ON.
+type Kind string
+
+const (
+	KindCreated Kind = "created"
+	KindExists  Kind = "exists"
+	KindError   Kind = "error"
+	KindSkipped Kind = "skipped"
+)
+
+// Event describes one run outcome to announce.
+type Event struct {
+	Kind     Kind
+	IssueURL string
+	Date     string // "YYYY-MM-DD" report date, when known
+	Err      error  // set when Kind == KindError
+}
+
+// Notifier delivers an Event to one channel.
+type Notifier interface {
+	Name() string
+	Send(ctx context.Context, event Event) error
+}
+
+// Broadcaster fans an Event out to every configured Notifier, subject to the
+// NOTIFY_ON filter, retrying each delivery with exponential backoff.
+type Broadcaster struct {
+	notifiers []Notifier
+	notifyOn  map[Kind]bool
+	retries   int
+	baseDelay time.Duration
+}
+
+// NewBroadcaster builds a Broadcaster. notifyOn, if non-empty, restricts
+// delivery to the listed kinds; an empty/nil notifyOn defaults to
+// KindCreated only, matching the prior hardcoded behavior of announcing
+// solely on a newly-created issue (not on skips, existing-issue reruns, or
+// errors).
+func NewBroadcaster(notifiers []Notifier, notifyOn []Kind) *Broadcaster {
+	if len(notifyOn) == 0 {
+		notifyOn = []Kind{KindCreated}
+	}
+	set := make(map[Kind]bool, len(notifyOn))
+	for _, k := range notifyOn {
+		set[k] = true
+	}
+	return &Broadcaster{notifiers: notifiers, notifyOn: set, retries: 3, baseDelay: 500 * time.Millisecond}
+}
+
+// Broadcast delivers event to every configured notifier allowed by the
+// NOTIFY_ON filter. Per-channel failures (after retries) are collected and
+// returned together rather than aborting the rest of the broadcast.
+func (b *Broadcaster) Broadcast(ctx context.Context, event Event) error {
+	if !b.notifyOn[event.Kind] {
+		return nil
+	}
+
+	var errs []error
+	for _, n := range b.notifiers {
+		if err := b.sendWithRetry(ctx, n, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("notify: %d channel(s) failed: %w", len(errs), joinErrs(errs))
+}
+
+func (b *Broadcaster) sendWithRetry(ctx context.Context, n Notifier, event Event) error {
+	var err error
+	for attempt := 0; attempt <= b.retries; attempt++ {
+		if err = n.Send(ctx, event); err == nil {
+			return nil
+		}
+		if attempt < b.retries {
+			delay := b.baseDelay * time.Duration(1<<attempt)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+	}
+	return err
+}
+
+func joinErrs(errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	msg := errs[0].Error()
+	for _, e := range errs[1:] {
+		msg += "; " + e.Error()
+	}
+	return fmt.Errorf("%s", msg)
+}
+
+// DefaultMessage renders the announcement text shared by every plain-text
+// channel (Slack/Lark/WeCom/Discord all start from this).
+func DefaultMessage(event Event) string {
+	switch event.Kind {
+	case KindCreated:
+		return fmt.Sprintf("今日日报已创建：%s", event.IssueURL)
+	case KindExists:
+		return fmt.Sprintf("今日日报已存在：%s", event.IssueURL)
+	case KindSkipped:
+		return "今日跳过：非中国大陆工作日"
+	case KindError:
+		return fmt.Sprintf("日报任务出错：%v", event.Err)
+	default:
+		return string(event.Kind)
+	}
+}
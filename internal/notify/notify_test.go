@@ -0,0 +1,131 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeNotifier records every Send call and fails the first failCount of
+// them before succeeding, so tests can exercise sendWithRetry without a
+// real webhook.
+type fakeNotifier struct {
+	name      string
+	failCount int
+	attempts  int
+	lastEvent Event
+}
+
+func (f *fakeNotifier) Name() string { return f.name }
+
+func (f *fakeNotifier) Send(ctx context.Context, event Event) error {
+	f.attempts++
+	f.lastEvent = event
+	if f.attempts <= f.failCount {
+		return errors.New("simulated failure")
+	}
+	return nil
+}
+
+func newTestBroadcaster(notifiers []Notifier, notifyOn []Kind) *Broadcaster {
+	b := NewBroadcaster(notifiers, notifyOn)
+	b.baseDelay = time.Millisecond
+	return b
+}
+
+func TestNewBroadcasterDefaultsNotifyOnToCreated(t *testing.T) {
+	n := &fakeNotifier{name: "slack"}
+	b := newTestBroadcaster([]Notifier{n}, nil)
+
+	if err := b.Broadcast(context.Background(), Event{Kind: KindSkipped}); err != nil {
+		t.Fatalf("Broadcast(skipped): unexpected error: %v", err)
+	}
+	if n.attempts != 0 {
+		t.Errorf("expected no delivery for KindSkipped with default NOTIFY_ON, got %d attempts", n.attempts)
+	}
+
+	if err := b.Broadcast(context.Background(), Event{Kind: KindCreated}); err != nil {
+		t.Fatalf("Broadcast(created): unexpected error: %v", err)
+	}
+	if n.attempts != 1 {
+		t.Errorf("expected one delivery for KindCreated with default NOTIFY_ON, got %d attempts", n.attempts)
+	}
+}
+
+func TestBroadcastHonorsExplicitNotifyOn(t *testing.T) {
+	n := &fakeNotifier{name: "slack"}
+	b := newTestBroadcaster([]Notifier{n}, []Kind{KindError, KindSkipped})
+
+	if err := b.Broadcast(context.Background(), Event{Kind: KindCreated}); err != nil {
+		t.Fatalf("Broadcast(created): unexpected error: %v", err)
+	}
+	if n.attempts != 0 {
+		t.Errorf("expected KindCreated to be filtered out, got %d attempts", n.attempts)
+	}
+
+	if err := b.Broadcast(context.Background(), Event{Kind: KindError}); err != nil {
+		t.Fatalf("Broadcast(error): unexpected error: %v", err)
+	}
+	if n.attempts != 1 {
+		t.Errorf("expected KindError to be delivered, got %d attempts", n.attempts)
+	}
+}
+
+func TestSendWithRetrySucceedsAfterTransientFailures(t *testing.T) {
+	n := &fakeNotifier{name: "slack", failCount: 2}
+	b := newTestBroadcaster([]Notifier{n}, []Kind{KindCreated})
+
+	if err := b.Broadcast(context.Background(), Event{Kind: KindCreated, IssueURL: "https://example/1"}); err != nil {
+		t.Fatalf("Broadcast: unexpected error: %v", err)
+	}
+	if n.attempts != 3 {
+		t.Errorf("attempts = %d, want 3 (2 failures + 1 success)", n.attempts)
+	}
+	if n.lastEvent.IssueURL != "https://example/1" {
+		t.Errorf("lastEvent.IssueURL = %q, want the broadcast event's URL", n.lastEvent.IssueURL)
+	}
+}
+
+func TestSendWithRetryGivesUpAfterExhaustingRetries(t *testing.T) {
+	n := &fakeNotifier{name: "slack", failCount: 100}
+	b := newTestBroadcaster([]Notifier{n}, []Kind{KindCreated})
+
+	err := b.Broadcast(context.Background(), Event{Kind: KindCreated})
+	if err == nil {
+		t.Fatal("expected Broadcast to return an error after exhausting retries")
+	}
+	if n.attempts != b.retries+1 {
+		t.Errorf("attempts = %d, want %d (initial try + %d retries)", n.attempts, b.retries+1, b.retries)
+	}
+}
+
+func TestSendWithRetryStopsOnContextCancellation(t *testing.T) {
+	n := &fakeNotifier{name: "slack", failCount: 100}
+	b := newTestBroadcaster([]Notifier{n}, []Kind{KindCreated})
+	b.baseDelay = time.Second // long enough that cancellation wins the race
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := b.Broadcast(ctx, Event{Kind: KindCreated}); err == nil {
+		t.Fatal("expected Broadcast to return an error for a canceled context")
+	}
+	if n.attempts != 1 {
+		t.Errorf("attempts = %d, want 1 (no retry after cancellation)", n.attempts)
+	}
+}
+
+func TestBroadcastCollectsErrorsFromAllChannels(t *testing.T) {
+	a := &fakeNotifier{name: "slack", failCount: 100}
+	c := &fakeNotifier{name: "lark", failCount: 100}
+	b := newTestBroadcaster([]Notifier{a, c}, []Kind{KindCreated})
+
+	err := b.Broadcast(context.Background(), Event{Kind: KindCreated})
+	if err == nil {
+		t.Fatal("expected an error when every channel fails")
+	}
+	if a.attempts == 0 || c.attempts == 0 {
+		t.Error("expected both channels to be attempted even though one already failed")
+	}
+}
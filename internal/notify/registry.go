@@ -0,0 +1,63 @@
+package notify
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// BroadcasterFromEnv builds a Broadcaster from the channels whose env vars
+// are present, plus the optional NOTIFY_ON=created,exists,error,skipped
+// filter. Channels left unconfigured are simply not included. NOTIFY_ON
+// left unset defaults to "created" only (see NewBroadcaster), so upgrading
+// a deployment that already has a webhook configured doesn't silently start
+// announcing skips and reruns it never used to.
+func BroadcasterFromEnv() (*Broadcaster, error) {
+	var notifiers []Notifier
+
+	if url := strings.TrimSpace(os.Getenv("SLACK_WEBHOOK_URL")); url != "" {
+		notifiers = append(notifiers, NewSlackNotifier(url))
+	}
+	if url := strings.TrimSpace(os.Getenv("LARK_WEBHOOK_URL")); url != "" {
+		notifiers = append(notifiers, NewLarkNotifier(url))
+	}
+	if url := strings.TrimSpace(os.Getenv("WECOM_WEBHOOK_URL")); url != "" {
+		notifiers = append(notifiers, NewWeComNotifier(url))
+	}
+	if url := strings.TrimSpace(os.Getenv("DISCORD_WEBHOOK_URL")); url != "" {
+		notifiers = append(notifiers, NewDiscordNotifier(url))
+	}
+	if url := strings.TrimSpace(os.Getenv("WEBHOOK_URL")); url != "" {
+		tmpl := os.Getenv("WEBHOOK_TEMPLATE")
+		if tmpl == "" {
+			tmpl = `{"kind":"{{.Kind}}","message":{{.Message | printf "%q"}}}`
+		}
+		n, err := NewWebhookNotifier(url, tmpl)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, n)
+	}
+	if host := strings.TrimSpace(os.Getenv("SMTP_HOST")); host != "" {
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = "587"
+		}
+		to := os.Getenv("SMTP_TO")
+		if to == "" {
+			return nil, fmt.Errorf("notify: SMTP_HOST set but SMTP_TO is empty")
+		}
+		notifiers = append(notifiers, NewEmailNotifier(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), os.Getenv("SMTP_FROM"), to))
+	}
+
+	var notifyOn []Kind
+	if raw := strings.TrimSpace(os.Getenv("NOTIFY_ON")); raw != "" {
+		for _, k := range strings.Split(raw, ",") {
+			if k = strings.TrimSpace(k); k != "" {
+				notifyOn = append(notifyOn, Kind(k))
+			}
+		}
+	}
+
+	return NewBroadcaster(notifiers, notifyOn), nil
+}
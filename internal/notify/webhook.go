@@ -0,0 +1,55 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"text/template"
+)
+
+// WebhookNotifier posts a Go-template-rendered body to an arbitrary HTTP
+// endpoint, for integrations that don't match any of the built-in formats.
+type WebhookNotifier struct {
+	url  string
+	tmpl *template.Template
+}
+
+// NewWebhookNotifier parses bodyTemplate as a text/template with access to
+// the Event fields (.Kind, .IssueURL, .Date, .Err, .Message).
+func NewWebhookNotifier(url, bodyTemplate string) (*WebhookNotifier, error) {
+	tmpl, err := template.New("webhook").Parse(bodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("webhook notifier: parsing template: %w", err)
+	}
+	return &WebhookNotifier{url: url, tmpl: tmpl}, nil
+}
+
+func (w *WebhookNotifier) Name() string { return "webhook" }
+
+func (w *WebhookNotifier) Send(ctx context.Context, event Event) error {
+	data := struct {
+		Event
+		Message string
+	}{Event: event, Message: DefaultMessage(event)}
+
+	var body bytes.Buffer
+	if err := w.tmpl.Execute(&body, data); err != nil {
+		return fmt.Errorf("webhook notifier: rendering template: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook failed: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package notify
+
+import "context"
+
+// WeComNotifier posts a markdown message to a WeChat Work (企业微信) group
+// robot webhook.
+type WeComNotifier struct {
+	webhookURL string
+}
+
+func NewWeComNotifier(webhookURL string) *WeComNotifier {
+	return &WeComNotifier{webhookURL: webhookURL}
+}
+
+func (w *WeComNotifier) Name() string { return "wecom" }
+
+func (w *WeComNotifier) Send(ctx context.Context, event Event) error {
+	payload := map[string]any{
+		"msgtype": "markdown",
+		"markdown": map[string]any{
+			"content": DefaultMessage(event),
+		},
+	}
+	return postJSON(ctx, w.webhookURL, payload)
+}
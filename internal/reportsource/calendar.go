@@ -0,0 +1,100 @@
+package reportsource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// CalendarSource fetches an ICS feed (a published Google Calendar secret
+// address works fine) and reports events that fall within the window as
+// yesterday's meetings.
+type CalendarSource struct {
+	icsURL string
+}
+
+// NewCalendarSource builds a CalendarSource over the given ICS feed URL.
+func NewCalendarSource(icsURL string) *CalendarSource {
+	return &CalendarSource{icsURL: icsURL}
+}
+
+func (s *CalendarSource) Name() string { return "calendar" }
+
+func (s *CalendarSource) Collect(ctx context.Context, from, to time.Time) ([]ReportItem, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.icsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("calendar fetch failed: %s", resp.Status)
+	}
+
+	events, err := parseICSEvents(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []ReportItem
+	for _, ev := range events {
+		if ev.Start.Before(from) || !ev.Start.Before(to) {
+			continue
+		}
+		items = append(items, ReportItem{
+			Section: SectionYesterday,
+			Text:    fmt.Sprintf("会议：%s（%s）", ev.Summary, ev.Start.Format("15:04")),
+		})
+	}
+	return items, nil
+}
+
+type icsEvent struct {
+	Summary string
+	Start   time.Time
+}
+
+// parseICSEvents is a minimal VEVENT parser: it only understands the
+// SUMMARY and DTSTART fields, which is all this source needs.
+func parseICSEvents(r io.Reader) ([]icsEvent, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []icsEvent
+	var cur *icsEvent
+	for _, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case line == "BEGIN:VEVENT":
+			cur = &icsEvent{}
+		case line == "END:VEVENT":
+			if cur != nil {
+				events = append(events, *cur)
+				cur = nil
+			}
+		case cur != nil && strings.HasPrefix(line, "SUMMARY:"):
+			cur.Summary = strings.TrimPrefix(line, "SUMMARY:")
+		case cur != nil && strings.HasPrefix(line, "DTSTART"):
+			idx := strings.IndexByte(line, ':')
+			if idx < 0 {
+				continue
+			}
+			val := line[idx+1:]
+			for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+				if t, err := time.Parse(layout, val); err == nil {
+					cur.Start = t
+					break
+				}
+			}
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,182 @@
+package reportsource
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// Config controls which sources are enabled and how they are built.
+// It is populated from env vars, with a YAML file (see mergeConfigFile)
+// pointed to by REPORT_SOURCES_CONFIG filling in fields the env left unset.
+type Config struct {
+	Enabled []string // e.g. "github", "gitlog", "calendar", "ticket"
+	Timeout time.Duration
+
+	GitHubToken string
+	GitHubOrg   string
+	GitHubUser  string
+
+	GitLogRepoPath string
+	GitLogAuthor   string
+
+	CalendarICSURL string
+
+	TicketBaseURL string
+	TicketToken   string
+	TicketJQL     string
+	TicketKind    string // "jira" or "linear"
+}
+
+// ConfigFromEnv builds a Config from environment variables, using the given
+// GitHub defaults (token/owner) when the GitHub source doesn't override them.
+func ConfigFromEnv(defaultToken, defaultOrg string) Config {
+	cfg := Config{
+		Enabled:     splitCSV(os.Getenv("REPORT_SOURCES")),
+		Timeout:     durationOrDefault(os.Getenv("REPORT_SOURCE_TIMEOUT"), 10*time.Second),
+		GitHubToken: envOr("REPORT_GITHUB_TOKEN", defaultToken),
+		GitHubOrg:   envOr("REPORT_GITHUB_ORG", ""),
+		GitHubUser:  os.Getenv("REPORT_GITHUB_USER"),
+
+		GitLogRepoPath: os.Getenv("REPORT_GITLOG_PATH"),
+		GitLogAuthor:   os.Getenv("REPORT_GITLOG_AUTHOR"),
+
+		CalendarICSURL: os.Getenv("REPORT_CALENDAR_ICS_URL"),
+
+		TicketBaseURL: os.Getenv("REPORT_TICKET_BASE_URL"),
+		TicketToken:   os.Getenv("REPORT_TICKET_TOKEN"),
+		TicketJQL:     os.Getenv("REPORT_TICKET_JQL"),
+		TicketKind:    envOr("REPORT_TICKET_KIND", ""),
+	}
+	if path := os.Getenv("REPORT_SOURCES_CONFIG"); path != "" {
+		if err := mergeConfigFile(&cfg, path); err == nil {
+			// YAML overrides take effect only for fields left unset by env,
+			// except timeout (see mergeConfigFile).
+		}
+	}
+	cfg.GitHubOrg = envDefaultString(cfg.GitHubOrg, defaultOrg)
+	cfg.TicketKind = envDefaultString(cfg.TicketKind, "jira")
+	return cfg
+}
+
+// Build constructs the enabled ReportSource implementations in a fixed,
+// deterministic order so issue bodies render consistently run to run.
+func (c Config) Build() []ReportSource {
+	enabled := toSet(c.Enabled)
+	var sources []ReportSource
+	if enabled["github"] && c.GitHubToken != "" && c.GitHubOrg != "" {
+		sources = append(sources, NewGitHubSource(c.GitHubToken, c.GitHubOrg, c.GitHubUser))
+	}
+	if enabled["gitlog"] && c.GitLogRepoPath != "" {
+		sources = append(sources, NewGitLogSource(c.GitLogRepoPath, c.GitLogAuthor))
+	}
+	if enabled["calendar"] && c.CalendarICSURL != "" {
+		sources = append(sources, NewCalendarSource(c.CalendarICSURL))
+	}
+	if enabled["ticket"] && c.TicketBaseURL != "" && c.TicketToken != "" {
+		sources = append(sources, NewTicketSource(c.TicketKind, c.TicketBaseURL, c.TicketToken, c.TicketJQL))
+	}
+	return sources
+}
+
+func envOr(key, fallback string) string {
+	if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func splitCSV(s string) []string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func toSet(vals []string) map[string]bool {
+	set := make(map[string]bool, len(vals))
+	for _, v := range vals {
+		set[strings.ToLower(v)] = true
+	}
+	return set
+}
+
+func durationOrDefault(s string, fallback time.Duration) time.Duration {
+	if s == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+// mergeConfigFile fills in zero-value fields of cfg from a small flat YAML
+// file (key: value per line, no nesting). The one exception is "timeout": a
+// valid duration in the file replaces cfg.Timeout even when it is already set.
+// It intentionally avoids pulling in a YAML dependency since the rest of the
+// repo is dependency-free.
+func mergeConfigFile(cfg *Config, path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		idx := strings.IndexByte(line, ':')
+		if idx <= 0 {
+			continue
+		}
+		key := strings.TrimSpace(line[:idx])
+		val := strings.Trim(strings.TrimSpace(line[idx+1:]), `"'`)
+		switch key {
+		case "enabled":
+			if len(cfg.Enabled) == 0 {
+				cfg.Enabled = splitCSV(val)
+			}
+		case "timeout":
+			if d, err := time.ParseDuration(val); err == nil {
+				cfg.Timeout = d
+			}
+		case "github_org":
+			cfg.GitHubOrg = envDefaultString(cfg.GitHubOrg, val)
+		case "github_user":
+			cfg.GitHubUser = envDefaultString(cfg.GitHubUser, val)
+		case "gitlog_path":
+			cfg.GitLogRepoPath = envDefaultString(cfg.GitLogRepoPath, val)
+		case "gitlog_author":
+			cfg.GitLogAuthor = envDefaultString(cfg.GitLogAuthor, val)
+		case "calendar_ics_url":
+			cfg.CalendarICSURL = envDefaultString(cfg.CalendarICSURL, val)
+		case "ticket_base_url":
+			cfg.TicketBaseURL = envDefaultString(cfg.TicketBaseURL, val)
+		case "ticket_token":
+			cfg.TicketToken = envDefaultString(cfg.TicketToken, val)
+		case "ticket_jql":
+			cfg.TicketJQL = envDefaultString(cfg.TicketJQL, val)
+		case "ticket_kind":
+			cfg.TicketKind = envDefaultString(cfg.TicketKind, val)
+		}
+	}
+	return nil
+}
+
+func envDefaultString(current, fallback string) string {
+	if current != "" {
+		return current
+	}
+	return fallback
+}
@@ -0,0 +1,87 @@
+package reportsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// GitHubSource collects PRs authored by a user and PRs reviewed by that user
+// in an org/user scope, using the GitHub search API. Commits are not
+// collected.
+type GitHubSource struct {
+	token string
+	org   string
+	user  string
+}
+
+// NewGitHubSource builds a GitHubSource. user is the GitHub login whose
+// activity should be collected; org scopes the search to "org:<org>".
+func NewGitHubSource(token, org, user string) *GitHubSource {
+	return &GitHubSource{token: token, org: org, user: user}
+}
+
+func (s *GitHubSource) Name() string { return "github" }
+
+func (s *GitHubSource) Collect(ctx context.Context, from, to time.Time) ([]ReportItem, error) {
+	if s.user == "" {
+		return nil, fmt.Errorf("github source: REPORT_GITHUB_USER not set")
+	}
+	var items []ReportItem
+
+	prItems, err := s.searchIssues(ctx, fmt.Sprintf("org:%s author:%s is:pr", s.org, s.user), from, to, SectionYesterday)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, prItems...)
+
+	reviewItems, err := s.searchIssues(ctx, fmt.Sprintf("org:%s reviewed-by:%s is:pr", s.org, s.user), from, to, SectionYesterday)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, reviewItems...)
+
+	return items, nil
+}
+
+func (s *GitHubSource) searchIssues(ctx context.Context, query string, from, to time.Time, section string) ([]ReportItem, error) {
+	// The search date range is inclusive, so end on the last day before to.
+	q := fmt.Sprintf("%s updated:%s..%s", query, from.Format("2006-01-02"), to.Add(-time.Second).Format("2006-01-02"))
+	endpoint := "https://api.github.com/search/issues?q=" + url.QueryEscape(q) + "&per_page=50"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("github search failed: %s", resp.Status)
+	}
+
+	var out struct {
+		Items []struct {
+			Title   string `json:"title"`
+			HTMLURL string `json:"html_url"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	items := make([]ReportItem, 0, len(out.Items))
+	for _, it := range out.Items {
+		items = append(items, ReportItem{Section: section, Text: fmt.Sprintf("%s (%s)", it.Title, it.HTMLURL)})
+	}
+	return items, nil
+}
@@ -0,0 +1,55 @@
+package reportsource
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// GitLogSource walks the commit log of a locally-cloned repo, filtering by
+// author and by commit date window. It shells out to the system `git`
+// binary rather than linking a git library, matching the repo's preference
+// for a small, dependency-free binary.
+type GitLogSource struct {
+	repoPath string
+	author   string
+}
+
+// NewGitLogSource builds a GitLogSource over repoPath. author filters commits
+// via `git log --author`; an empty author includes all commits.
+func NewGitLogSource(repoPath, author string) *GitLogSource {
+	return &GitLogSource{repoPath: repoPath, author: author}
+}
+
+func (s *GitLogSource) Name() string { return "gitlog" }
+
+func (s *GitLogSource) Collect(ctx context.Context, from, to time.Time) ([]ReportItem, error) {
+	args := []string{
+		"-C", s.repoPath,
+		"log",
+		"--since=" + from.Format("2006-01-02 15:04:05"),
+		"--until=" + to.Format("2006-01-02 15:04:05"),
+		"--pretty=format:%s",
+	}
+	if s.author != "" {
+		args = append(args, "--author="+s.author)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log failed: %w", err)
+	}
+
+	var items []ReportItem
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		items = append(items, ReportItem{Section: SectionYesterday, Text: line})
+	}
+	return items, nil
+}
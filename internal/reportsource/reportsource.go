@@ -0,0 +1,86 @@
+// Package reportsource collects raw activity (commits, PRs, meetings, tickets, ...)
+// over a time window and renders it into the sections of a daily report issue body.
+package reportsource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Section names used when rendering collected items into the issue body.
+const (
+	SectionYesterday = "昨日进展"
+	SectionToday     = "今日计划"
+	SectionRisk      = "风险/阻塞"
+)
+
+// ReportItem is a single bullet contributed by a ReportSource.
+type ReportItem struct {
+	Section string // one of the Section* constants; defaults to SectionYesterday if empty
+	Text    string
+}
+
+// ReportSource collects report items for the window [from, to).
+// Implementations must respect ctx cancellation/deadline and return promptly on timeout.
+type ReportSource interface {
+	Name() string
+	Collect(ctx context.Context, from, to time.Time) ([]ReportItem, error)
+}
+
+// Collect runs every source with its own timeout and merges the results.
+// A source that errors or times out does not abort the run: its failure is
+// recorded as a single warning item under the risk/blocker section instead.
+func Collect(ctx context.Context, sources []ReportSource, from, to time.Time, timeout time.Duration) []ReportItem {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	var items []ReportItem
+	for _, src := range sources {
+		sctx, cancel := context.WithTimeout(ctx, timeout)
+		got, err := src.Collect(sctx, from, to)
+		cancel()
+		if err != nil {
+			items = append(items, ReportItem{
+				Section: SectionRisk,
+				Text:    fmt.Sprintf("⚠️ source %s unavailable: %v", src.Name(), err),
+			})
+			continue
+		}
+		items = append(items, got...)
+	}
+	return items
+}
+
+// Render groups items by section and formats them as the familiar
+// "昨日进展 / 今日计划 / 风险阻塞" markdown body. Sections with no items still
+// print their header with a placeholder bullet so the body stays editable.
+func Render(items []ReportItem) string {
+	bySection := map[string][]string{}
+	order := []string{SectionYesterday, SectionToday, SectionRisk}
+	for _, it := range items {
+		section := it.Section
+		if section == "" {
+			section = SectionYesterday
+		}
+		bySection[section] = append(bySection[section], it.Text)
+	}
+
+	var b strings.Builder
+	for i, section := range order {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s：\n", section)
+		lines := bySection[section]
+		if len(lines) == 0 {
+			b.WriteString("- \n")
+			continue
+		}
+		for _, line := range lines {
+			fmt.Fprintf(&b, "- %s\n", line)
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,121 @@
+package reportsource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TicketSource collects assigned tickets from Jira or Linear. Jira runs the
+// configured JQL (default: updated within the last day before now) and does
+// not apply the from/to window; Linear takes tickets updated at or after
+// from, with no upper bound.
+type TicketSource struct {
+	kind    string // "jira" or "linear"
+	baseURL string
+	token   string
+	jql     string // jira only; linear uses a fixed GraphQL query
+}
+
+// NewTicketSource builds a TicketSource for the given backend kind.
+func NewTicketSource(kind, baseURL, token, jql string) *TicketSource {
+	return &TicketSource{kind: kind, baseURL: baseURL, token: token, jql: jql}
+}
+
+func (s *TicketSource) Name() string { return "ticket:" + s.kind }
+
+func (s *TicketSource) Collect(ctx context.Context, from, to time.Time) ([]ReportItem, error) {
+	switch s.kind {
+	case "linear":
+		return s.collectLinear(ctx, from, to)
+	default:
+		return s.collectJira(ctx, from, to)
+	}
+}
+
+func (s *TicketSource) collectJira(ctx context.Context, from, to time.Time) ([]ReportItem, error) {
+	jql := s.jql
+	if jql == "" {
+		jql = "assignee = currentUser() AND updated >= -1d"
+	}
+	endpoint := fmt.Sprintf("%s/rest/api/2/search?jql=%s", s.baseURL, url.QueryEscape(jql))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jira search failed: %s", resp.Status)
+	}
+
+	var out struct {
+		Issues []struct {
+			Key    string `json:"key"`
+			Fields struct {
+				Summary string `json:"summary"`
+			} `json:"fields"`
+		} `json:"issues"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	items := make([]ReportItem, 0, len(out.Issues))
+	for _, issue := range out.Issues {
+		items = append(items, ReportItem{Section: SectionToday, Text: fmt.Sprintf("%s %s", issue.Key, issue.Fields.Summary)})
+	}
+	return items, nil
+}
+
+func (s *TicketSource) collectLinear(ctx context.Context, from, to time.Time) ([]ReportItem, error) {
+	query := `{"query":"{ viewer { assignedIssues(filter: { updatedAt: { gte: \"` + from.Format(time.RFC3339) + `\" } }) { nodes { identifier title } } } }"}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/graphql", bytes.NewReader([]byte(query)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", s.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("linear query failed: %s", resp.Status)
+	}
+
+	var out struct {
+		Data struct {
+			Viewer struct {
+				AssignedIssues struct {
+					Nodes []struct {
+						Identifier string `json:"identifier"`
+						Title      string `json:"title"`
+					} `json:"nodes"`
+				} `json:"assignedIssues"`
+			} `json:"viewer"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	nodes := out.Data.Viewer.AssignedIssues.Nodes
+	items := make([]ReportItem, 0, len(nodes))
+	for _, n := range nodes {
+		items = append(items, ReportItem{Section: SectionToday, Text: fmt.Sprintf("%s %s", n.Identifier, n.Title)})
+	}
+	return items, nil
+}
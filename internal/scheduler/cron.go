@@ -0,0 +1,150 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed standard 5-field cron expression:
+// "minute hour day-of-month month day-of-week", all in the location it is
+// evaluated against. It supports "*", "*/n", lists ("1,2,3") and simple
+// ranges ("1-5"); it does not support named months/weekdays or the "?"
+// placeholder some cron dialects add.
+//
+// When both day-of-month and day-of-week are restricted (neither is "*"),
+// they're combined with OR rather than AND, matching standard cron
+// semantics (and most other cron implementations): "0 9 1,15 * 1-5" fires
+// on the 1st, the 15th, *and* every weekday, not only on a 1st/15th that
+// also happens to be a weekday.
+type CronSchedule struct {
+	expr              string
+	minute            fieldMatcher
+	hour              fieldMatcher
+	dom               fieldMatcher
+	month             fieldMatcher
+	weekday           fieldMatcher
+	domRestricted     bool
+	weekdayRestricted bool
+}
+
+type fieldMatcher func(v int) bool
+
+// ParseCron parses a standard 5-field cron expression.
+func ParseCron(expr string) (*CronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron: expected 5 fields, got %d in %q", len(fields), expr)
+	}
+	minute, err := parseField(fields[0], 0, 59)
+	if err != nil {
+		return nil, fmt.Errorf("cron: minute: %w", err)
+	}
+	hour, err := parseField(fields[1], 0, 23)
+	if err != nil {
+		return nil, fmt.Errorf("cron: hour: %w", err)
+	}
+	dom, err := parseField(fields[2], 1, 31)
+	if err != nil {
+		return nil, fmt.Errorf("cron: day-of-month: %w", err)
+	}
+	month, err := parseField(fields[3], 1, 12)
+	if err != nil {
+		return nil, fmt.Errorf("cron: month: %w", err)
+	}
+	weekday, err := parseField(fields[4], 0, 6)
+	if err != nil {
+		return nil, fmt.Errorf("cron: day-of-week: %w", err)
+	}
+	return &CronSchedule{
+		expr:              expr,
+		minute:            minute,
+		hour:              hour,
+		dom:               dom,
+		month:             month,
+		weekday:           weekday,
+		domRestricted:     fields[2] != "*",
+		weekdayRestricted: fields[4] != "*",
+	}, nil
+}
+
+func (c *CronSchedule) String() string { return c.expr }
+
+// Next returns the first instant strictly after `after` that matches the
+// schedule, evaluated in after's location. It scans minute-by-minute, which
+// is plenty fast for the once-a-day cadence this tool schedules.
+func (c *CronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	loc := after.Location()
+	t = t.In(loc)
+	// Bounded scan: a valid cron expression always matches within 4 years.
+	limit := t.AddDate(4, 0, 0)
+	for t.Before(limit) {
+		if c.month(int(t.Month())) && c.dayMatches(t) && c.hour(t.Hour()) && c.minute(t.Minute()) {
+			return t
+		}
+		t = t.Add(time.Minute)
+	}
+	return limit
+}
+
+// dayMatches reports whether t's day satisfies the day-of-month and
+// day-of-week fields, ORing them when both are restricted and ANDing
+// otherwise (see the CronSchedule doc comment).
+func (c *CronSchedule) dayMatches(t time.Time) bool {
+	if c.domRestricted && c.weekdayRestricted {
+		return c.dom(t.Day()) || c.weekday(int(t.Weekday()))
+	}
+	return c.dom(t.Day()) && c.weekday(int(t.Weekday()))
+}
+
+func parseField(raw string, min, max int) (fieldMatcher, error) {
+	if raw == "*" {
+		return func(int) bool { return true }, nil
+	}
+
+	allowed := map[int]bool{}
+	for _, part := range strings.Split(raw, ",") {
+		if err := parsePart(part, min, max, allowed); err != nil {
+			return nil, err
+		}
+	}
+	return func(v int) bool { return allowed[v] }, nil
+}
+
+func parsePart(part string, min, max int, allowed map[int]bool) error {
+	step := 1
+	base := part
+	if idx := strings.IndexByte(part, '/'); idx >= 0 {
+		base = part[:idx]
+		s, err := strconv.Atoi(part[idx+1:])
+		if err != nil || s <= 0 {
+			return fmt.Errorf("invalid step in %q", part)
+		}
+		step = s
+	}
+
+	lo, hi := min, max
+	if base != "*" {
+		if idx := strings.IndexByte(base, '-'); idx >= 0 {
+			a, err1 := strconv.Atoi(base[:idx])
+			b, err2 := strconv.Atoi(base[idx+1:])
+			if err1 != nil || err2 != nil || a < min || b > max || a > b {
+				return fmt.Errorf("invalid range %q", base)
+			}
+			lo, hi = a, b
+		} else {
+			v, err := strconv.Atoi(base)
+			if err != nil || v < min || v > max {
+				return fmt.Errorf("invalid value %q", base)
+			}
+			lo, hi = v, v
+		}
+	}
+
+	for v := lo; v <= hi; v += step {
+		allowed[v] = true
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronErrors(t *testing.T) {
+	cases := []string{
+		"",
+		"0 10 * *",
+		"60 10 * * *",
+		"0 24 * * *",
+		"0 10 0 * *",
+		"0 10 * 13 *",
+		"0 10 * * 7",
+		"*/0 10 * * *",
+		"1-60 * * * *",
+	}
+	for _, expr := range cases {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q): expected error, got nil", expr)
+		}
+	}
+}
+
+func TestParseCronValid(t *testing.T) {
+	cases := []string{
+		"0 10 * * *",
+		"*/15 * * * *",
+		"0,30 9-17 * * 1-5",
+		"0 0 1 1 *",
+	}
+	for _, expr := range cases {
+		if _, err := ParseCron(expr); err != nil {
+			t.Errorf("ParseCron(%q): unexpected error: %v", expr, err)
+		}
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	loc := time.UTC
+
+	t.Run("daily at fixed time", func(t *testing.T) {
+		sched, err := ParseCron("0 10 * * *")
+		if err != nil {
+			t.Fatal(err)
+		}
+		after := time.Date(2026, 7, 29, 9, 0, 0, 0, loc)
+		want := time.Date(2026, 7, 29, 10, 0, 0, 0, loc)
+		if got := sched.Next(after); !got.Equal(want) {
+			t.Errorf("Next(%v) = %v, want %v", after, got, want)
+		}
+	})
+
+	t.Run("rolls to next day once time has passed", func(t *testing.T) {
+		sched, err := ParseCron("0 10 * * *")
+		if err != nil {
+			t.Fatal(err)
+		}
+		after := time.Date(2026, 7, 29, 10, 0, 0, 0, loc)
+		want := time.Date(2026, 7, 30, 10, 0, 0, 0, loc)
+		if got := sched.Next(after); !got.Equal(want) {
+			t.Errorf("Next(%v) = %v, want %v", after, got, want)
+		}
+	})
+
+	t.Run("step expression", func(t *testing.T) {
+		sched, err := ParseCron("*/15 * * * *")
+		if err != nil {
+			t.Fatal(err)
+		}
+		after := time.Date(2026, 7, 29, 9, 5, 0, 0, loc)
+		want := time.Date(2026, 7, 29, 9, 15, 0, 0, loc)
+		if got := sched.Next(after); !got.Equal(want) {
+			t.Errorf("Next(%v) = %v, want %v", after, got, want)
+		}
+	})
+
+	t.Run("weekday list skips weekend", func(t *testing.T) {
+		sched, err := ParseCron("0 9 * * 1-5")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 2026-07-31 is a Friday; next weekday fire should be Monday 2026-08-03.
+		after := time.Date(2026, 7, 31, 9, 0, 0, 0, loc)
+		want := time.Date(2026, 8, 3, 9, 0, 0, 0, loc)
+		if got := sched.Next(after); !got.Equal(want) {
+			t.Errorf("Next(%v) = %v, want %v", after, got, want)
+		}
+	})
+
+	t.Run("dom and weekday both restricted combine with OR", func(t *testing.T) {
+		// 2026-08-01 is a Saturday and the 1st of the month; with standard
+		// cron OR semantics this should fire both on the 1st/15th *and* on
+		// any weekday, not only when a date is both at once.
+		sched, err := ParseCron("0 9 1,15 * 1-5")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 2026-07-31 is a Friday (weekday match) but not the 1st/15th.
+		after := time.Date(2026, 7, 31, 9, 0, 0, 0, loc)
+		want := time.Date(2026, 8, 1, 9, 0, 0, 0, loc) // Saturday, matches via dom=1
+		if got := sched.Next(after); !got.Equal(want) {
+			t.Errorf("Next(%v) = %v, want %v", after, got, want)
+		}
+
+		after = time.Date(2026, 8, 1, 9, 0, 0, 0, loc)
+		want = time.Date(2026, 8, 3, 9, 0, 0, 0, loc) // Monday, matches via weekday, not dom
+		if got := sched.Next(after); !got.Equal(want) {
+			t.Errorf("Next(%v) = %v, want %v", after, got, want)
+		}
+	})
+
+	t.Run("dom restricted, weekday wildcard combines with AND", func(t *testing.T) {
+		sched, err := ParseCron("0 9 15 * *")
+		if err != nil {
+			t.Fatal(err)
+		}
+		after := time.Date(2026, 7, 31, 9, 0, 0, 0, loc)
+		want := time.Date(2026, 8, 15, 9, 0, 0, 0, loc)
+		if got := sched.Next(after); !got.Equal(want) {
+			t.Errorf("Next(%v) = %v, want %v", after, got, want)
+		}
+	})
+
+	t.Run("evaluated in after's location", func(t *testing.T) {
+		sched, err := ParseCron("0 10 * * *")
+		if err != nil {
+			t.Fatal(err)
+		}
+		shanghai, err := time.LoadLocation("Asia/Shanghai")
+		if err != nil {
+			t.Skipf("tzdata unavailable: %v", err)
+		}
+		after := time.Date(2026, 7, 29, 9, 0, 0, 0, shanghai)
+		want := time.Date(2026, 7, 29, 10, 0, 0, 0, shanghai)
+		got := sched.Next(after)
+		if !got.Equal(want) {
+			t.Errorf("Next(%v) = %v, want %v", after, got, want)
+		}
+		if got.Location().String() != shanghai.String() {
+			t.Errorf("Next returned location %v, want %v", got.Location(), shanghai)
+		}
+	})
+}
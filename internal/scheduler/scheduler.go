@@ -0,0 +1,181 @@
+// Package scheduler runs a function on one or more cron schedules, with
+// random jitter to avoid thundering-herd against downstream APIs and a
+// persisted state file so a restarted process can catch up on a missed run.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// catchupWindow bounds how far back Scheduler looks for a missed run. A
+// schedule due more than this long ago is treated as stale and skipped
+// rather than fired, so a process down for weeks doesn't fire a storm of
+// backlog runs.
+const catchupWindow = 7 * 24 * time.Hour
+
+// catchupBackoffBase and catchupBackoffMax bound the delay between retries
+// of a repeatedly failing catch-up run, doubling on each consecutive
+// failure, so a transient outage during catch-up backs off instead of
+// hammering the downstream API in a tight loop.
+const (
+	catchupBackoffBase = 5 * time.Second
+	catchupBackoffMax  = 5 * time.Minute
+)
+
+// Scheduler fires a callback on the next matching instant across a set of
+// cron schedules, applying jitter and catch-up as configured.
+type Scheduler struct {
+	schedules       []*CronSchedule
+	jitter          time.Duration
+	statePath       string
+	st              state
+	catchupFailures int
+}
+
+// New builds a Scheduler from one or more cron expressions. jitter is the
+// maximum random delay added after each computed fire time. statePath, if
+// non-empty, persists last-run timestamps so a restart can detect and run a
+// missed schedule.
+func New(cronExprs []string, jitter time.Duration, statePath string) (*Scheduler, error) {
+	if len(cronExprs) == 0 {
+		return nil, fmt.Errorf("scheduler: at least one cron expression is required")
+	}
+	schedules := make([]*CronSchedule, 0, len(cronExprs))
+	for _, expr := range cronExprs {
+		sched, err := ParseCron(expr)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, sched)
+	}
+	st, err := loadState(statePath)
+	if err != nil {
+		return nil, fmt.Errorf("scheduler: loading state: %w", err)
+	}
+	return &Scheduler{schedules: schedules, jitter: jitter, statePath: statePath, st: st}, nil
+}
+
+// RunFunc is the work performed on each fire. scheduledFor is the instant the
+// schedule was due for (which may be in the past for a catch-up run).
+type RunFunc func(ctx context.Context, scheduledFor time.Time) error
+
+// Run blocks forever (until ctx is cancelled), firing fn on the next due
+// schedule each iteration. On startup, and after every fire, it checks for a
+// missed run within catchupWindow and fires immediately for it before
+// returning to normal waiting.
+func (s *Scheduler) Run(ctx context.Context, loc *time.Location, fn RunFunc) error {
+	for {
+		now := time.Now().In(loc)
+
+		if sched, missed, ok := s.findMissedRun(now); ok {
+			fmt.Printf("catch-up run for missed schedule %q (was due %s)\n", sched.String(), missed.Format(time.RFC3339))
+			if err := fn(ctx, missed); err == nil {
+				s.markRun(sched, missed)
+				s.catchupFailures = 0
+			} else {
+				wait := s.catchupBackoffDelay()
+				s.catchupFailures++
+				fmt.Printf("catch-up run failed: %v; retrying in %s\n", err, wait)
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(wait):
+				}
+			}
+			continue
+		}
+
+		sched, next := s.nextAcrossSchedules(now)
+		wait := time.Until(next) + s.jitterDelay()
+		fmt.Printf("next run at %s (schedule %q)\n", next.Format(time.RFC3339), sched.String())
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		if err := fn(ctx, next); err == nil {
+			s.markRun(sched, next)
+		}
+	}
+}
+
+// catchupBackoffDelay returns the delay before the next catch-up retry,
+// doubling per consecutive failure (capped at catchupBackoffMax) plus the
+// usual jitter. The exponent is clamped well short of overflowing the shift
+// so a long string of failures saturates at the cap instead of wrapping
+// around to a near-zero delay.
+func (s *Scheduler) catchupBackoffDelay() time.Duration {
+	exp := s.catchupFailures
+	if exp > 10 {
+		exp = 10
+	}
+	backoff := catchupBackoffBase * time.Duration(1<<uint(exp))
+	if backoff > catchupBackoffMax {
+		backoff = catchupBackoffMax
+	}
+	return backoff + s.jitterDelay()
+}
+
+func (s *Scheduler) jitterDelay() time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(s.jitter)))
+}
+
+func (s *Scheduler) markRun(sched *CronSchedule, at time.Time) {
+	s.st.LastRun[sched.String()] = at
+	_ = saveState(s.statePath, s.st)
+}
+
+// nextAcrossSchedules returns the soonest upcoming fire time across every
+// configured schedule.
+func (s *Scheduler) nextAcrossSchedules(after time.Time) (*CronSchedule, time.Time) {
+	var bestSched *CronSchedule
+	var best time.Time
+	for _, sched := range s.schedules {
+		next := sched.Next(after)
+		if bestSched == nil || next.Before(best) {
+			bestSched, best = sched, next
+		}
+	}
+	return bestSched, best
+}
+
+// findMissedRun looks for a schedule whose most recent due time is after its
+// last recorded successful run and far enough in the past (more than a
+// minute, to avoid racing the normal fire path) but within catchupWindow.
+func (s *Scheduler) findMissedRun(now time.Time) (*CronSchedule, time.Time, bool) {
+	cutoff := now.Add(-time.Minute)
+	oldest := now.Add(-catchupWindow)
+	for _, sched := range s.schedules {
+		due := prevFire(sched, now)
+		if due.IsZero() || due.Before(oldest) || due.After(cutoff) {
+			continue
+		}
+		if last, ok := s.st.LastRun[sched.String()]; ok && !due.After(last) {
+			continue
+		}
+		return sched, due, true
+	}
+	return nil, time.Time{}, false
+}
+
+// prevFire returns the most recent instant at or before `before` that
+// matches the schedule, scanning minute-by-minute back to catchupWindow.
+func prevFire(sched *CronSchedule, before time.Time) time.Time {
+	t := before.Truncate(time.Minute)
+	oldest := before.Add(-catchupWindow)
+	for !t.Before(oldest) {
+		if sched.Next(t.Add(-time.Minute)) == t {
+			return t
+		}
+		t = t.Add(-time.Minute)
+	}
+	return time.Time{}
+}
@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestRunCatchupRespectsContextCancellation guards against a failing
+// catch-up run retrying in a tight, un-cancellable loop: Run must still
+// notice ctx being done while backing off between catch-up retries, rather
+// than only checking ctx.Done() in the normal-wait branch.
+func TestRunCatchupRespectsContextCancellation(t *testing.T) {
+	due := time.Now().UTC().Add(-2 * time.Minute)
+	expr := fmt.Sprintf("%d %d %d %d %d", due.Minute(), due.Hour(), due.Day(), int(due.Month()), int(due.Weekday()))
+	sched, err := ParseCron(expr)
+	if err != nil {
+		t.Fatalf("ParseCron(%q): %v", expr, err)
+	}
+
+	s := &Scheduler{
+		schedules: []*CronSchedule{sched},
+		st:        state{LastRun: map[string]time.Time{}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	start := time.Now()
+	err = s.Run(ctx, time.UTC, func(ctx context.Context, scheduledFor time.Time) error {
+		calls++
+		return errors.New("simulated downstream failure")
+	})
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Run returned %v, want context.DeadlineExceeded", err)
+	}
+	// catchupBackoffBase is 5s; if Run only checked ctx.Done() in the
+	// normal-wait branch, this would block for at least that long (or
+	// forever, with an always-failing fn and no missed-run state change).
+	if elapsed > 2*time.Second {
+		t.Fatalf("Run took %s to observe context cancellation during catch-up backoff, want well under catchupBackoffBase", elapsed)
+	}
+	if calls == 0 {
+		t.Fatal("fn was never called; expected at least one catch-up attempt")
+	}
+}
+
+func TestCatchupBackoffDelayCapsAndDoesNotOverflow(t *testing.T) {
+	s := &Scheduler{}
+	var prev time.Duration
+	for i := 0; i < 50; i++ {
+		s.catchupFailures = i
+		d := s.catchupBackoffDelay()
+		if d < catchupBackoffBase || d > catchupBackoffMax {
+			t.Fatalf("catchupFailures=%d: delay %s out of bounds [%s, %s]", i, d, catchupBackoffBase, catchupBackoffMax)
+		}
+		if i > 0 && d < prev-catchupBackoffBase {
+			t.Fatalf("catchupFailures=%d: delay %s dropped below previous %s, backoff should not shrink", i, d, prev)
+		}
+		prev = d
+	}
+}
@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// state is the on-disk record of the last successful run per cron
+// expression, so a restarted process can tell whether a scheduled window
+// was missed while it was down.
+type state struct {
+	LastRun map[string]time.Time `json:"last_run"`
+}
+
+func loadState(path string) (state, error) {
+	st := state{LastRun: map[string]time.Time{}}
+	if path == "" {
+		return st, nil
+	}
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return st, nil
+	}
+	if err != nil {
+		return st, err
+	}
+	if err := json.Unmarshal(raw, &st); err != nil {
+		return st, err
+	}
+	if st.LastRun == nil {
+		st.LastRun = map[string]time.Time{}
+	}
+	return st, nil
+}
+
+func saveState(path string, st state) error {
+	if path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	raw, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0o644)
+}
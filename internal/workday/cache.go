@@ -0,0 +1,64 @@
+package workday
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// fileCache is a small JSON-file-backed cache of date -> is-workday,
+// keyed by "YYYY-MM-DD". It avoids re-querying the remote holiday API for a
+// date already resolved, which matters most for the 31-day backward walk in
+// PreviousWorkday.
+type fileCache struct {
+	path string
+	mu   sync.Mutex
+	data map[string]bool
+}
+
+func newFileCache(path string) *fileCache {
+	c := &fileCache{path: path, data: map[string]bool{}}
+	c.load()
+	return c
+}
+
+func (c *fileCache) load() {
+	if c.path == "" {
+		return
+	}
+	raw, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(raw, &c.data)
+}
+
+func (c *fileCache) get(dateKey string) (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.data[dateKey]
+	return v, ok
+}
+
+func (c *fileCache) set(dateKey string, isWorkday bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data[dateKey] = isWorkday
+	c.saveLocked()
+}
+
+// saveLocked writes the cache to disk; callers must hold c.mu.
+func (c *fileCache) saveLocked() {
+	if c.path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return
+	}
+	raw, err := json.Marshal(c.data)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(c.path, raw, 0o644)
+}
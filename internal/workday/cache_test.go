@@ -0,0 +1,46 @@
+package workday
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestFileCacheGetSet(t *testing.T) {
+	c := newFileCache(filepath.Join(t.TempDir(), "cache.json"))
+
+	if _, ok := c.get("2026-07-29"); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	c.set("2026-07-29", true)
+	v, ok := c.get("2026-07-29")
+	if !ok || !v {
+		t.Fatalf("get() = (%v, %v), want (true, true)", v, ok)
+	}
+}
+
+func TestFileCachePersistsAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "cache.json")
+
+	c1 := newFileCache(path)
+	c1.set("2026-01-01", false)
+
+	c2 := newFileCache(path)
+	v, ok := c2.get("2026-01-01")
+	if !ok || v {
+		t.Fatalf("get() after reload = (%v, %v), want (false, true)", v, ok)
+	}
+}
+
+func TestFileCacheEmptyPathDoesNotPersist(t *testing.T) {
+	c := newFileCache("")
+	c.set("2026-01-01", true)
+	v, ok := c.get("2026-01-01")
+	if !ok || !v {
+		t.Fatalf("get() = (%v, %v), want (true, true)", v, ok)
+	}
+
+	if _, ok := newFileCache("").get("2026-01-01"); ok {
+		t.Fatal("expected a fresh empty-path cache not to see the previous instance's entry")
+	}
+}
@@ -0,0 +1,56 @@
+package workday
+
+import (
+	_ "embed"
+	"strings"
+)
+
+//go:embed holidays.yaml
+var embeddedHolidaysYAML string
+
+// embeddedTable maps YYYY-MM-DD to whether that date is a workday, built
+// from the compiled-in holidays.yaml. IsWorkday consults it after the cache
+// and before the remote API.
+var embeddedTable = parseEmbeddedTable(embeddedHolidaysYAML)
+
+// parseEmbeddedTable reads the small subset of YAML used by holidays.yaml:
+// two top-level list keys ("holidays", "workdays"), each a list of
+// `- date: "YYYY-MM-DD"` / `  name: "..."` entries. A hand-rolled parser
+// keeps this dependency-free like the rest of the repo.
+func parseEmbeddedTable(raw string) map[string]bool {
+	table := map[string]bool{}
+	var section string
+	var pendingDate string
+
+	flush := func() {
+		if pendingDate == "" || section == "" {
+			return
+		}
+		table[pendingDate] = section == "workdays"
+		pendingDate = ""
+	}
+
+	for _, line := range strings.Split(raw, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		switch {
+		case trimmed == "holidays:":
+			flush()
+			section = "holidays"
+		case trimmed == "workdays:":
+			flush()
+			section = "workdays"
+		case strings.HasPrefix(trimmed, "- date:"):
+			flush()
+			pendingDate = unquote(strings.TrimSpace(strings.TrimPrefix(trimmed, "- date:")))
+		}
+	}
+	flush()
+	return table
+}
+
+func unquote(s string) string {
+	return strings.Trim(s, `"'`)
+}
@@ -0,0 +1,57 @@
+package workday
+
+import "testing"
+
+func TestParseEmbeddedTable(t *testing.T) {
+	raw := `# comment line, ignored
+holidays:
+  - date: "2025-01-01"
+    name: "元旦"
+  - date: "2025-10-01"
+    name: "国庆节"
+
+workdays:
+  - date: "2025-09-28"
+    name: "国庆节调休"
+`
+	table := parseEmbeddedTable(raw)
+
+	cases := map[string]bool{
+		"2025-01-01": false,
+		"2025-10-01": false,
+		"2025-09-28": true,
+	}
+	for date, want := range cases {
+		got, ok := table[date]
+		if !ok {
+			t.Errorf("table missing entry for %s", date)
+			continue
+		}
+		if got != want {
+			t.Errorf("table[%s] = %v, want %v", date, got, want)
+		}
+	}
+	if _, ok := table["2025-06-15"]; ok {
+		t.Error("table should not contain a date absent from the input")
+	}
+}
+
+func TestEmbeddedTableCoversKnown2026Holidays(t *testing.T) {
+	cases := map[string]bool{
+		"2026-01-01": false, // 元旦
+		"2026-02-17": false, // 春节
+		"2026-02-14": true,  // 春节调休
+		"2026-05-01": false, // 劳动节
+		"2026-10-01": false, // 国庆节
+	}
+	for date, want := range cases {
+		got, ok := embeddedTable[date]
+		if !ok {
+			t.Errorf("embeddedTable missing entry for %s", date)
+			continue
+		}
+		if got != want {
+			t.Errorf("embeddedTable[%s] = %v, want %v", date, got, want)
+		}
+	}
+}
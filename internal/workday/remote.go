@@ -0,0 +1,124 @@
+package workday
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// fetchRemote queries the configured China holiday API for a single date and
+// reports whether it is a workday. It retries transient failures (network
+// errors, 403/429) a few times before giving up.
+func fetchRemote(ctx context.Context, dateKey string) (bool, error) {
+	endpoint := os.Getenv("CHINA_WORKDAY_API")
+	if endpoint == "" {
+		endpoint = "https://timor.tech/api/holiday/info/" + dateKey
+	} else {
+		endpoint = strings.ReplaceAll(endpoint, "{date}", dateKey)
+	}
+
+	client := &http.Client{Timeout: 8 * time.Second}
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		req, rerr := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if rerr != nil {
+			return false, rerr
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; DailyIssueBot/1.0; +https://github.com)")
+		req.Header.Set("Referer", "https://timor.tech/")
+
+		resp, err = client.Do(req)
+		if err != nil {
+			if attempt < 2 {
+				time.Sleep(time.Duration(300*(attempt+1)) * time.Millisecond)
+				continue
+			}
+			return false, err
+		}
+		if resp.StatusCode == http.StatusOK {
+			break
+		}
+		if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+			resp.Body.Close()
+			if attempt < 2 {
+				time.Sleep(time.Duration(500*(attempt+1)) * time.Millisecond)
+				continue
+			}
+		}
+		defer resp.Body.Close()
+		return false, fmt.Errorf("holiday api status: %s", resp.Status)
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		Code int `json:"code"`
+		Type *struct {
+			Type int    `json:"type"` // 0 workday, 1 weekend, 2 holiday
+			Name string `json:"name"`
+		} `json:"type"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return false, err
+	}
+	if out.Code != 0 || out.Type == nil {
+		return false, errors.New("holiday api returned unknown")
+	}
+	return out.Type.Type == 0, nil
+}
+
+// fetchRemoteYear queries the whole-year endpoint, returning a map of
+// "YYYY-MM-DD" to is-workday for every date the API lists (public holidays,
+// and make-up workdays listed with holiday=false). Dates the API does not
+// list are absent from the map. Used to prefetch and warm the cache in one
+// request instead of up to 365 individual lookups.
+func fetchRemoteYear(ctx context.Context, year int) (map[string]bool, error) {
+	endpoint := os.Getenv("CHINA_WORKDAY_YEAR_API")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://timor.tech/api/holiday/year/%d", year)
+	} else {
+		endpoint = strings.ReplaceAll(endpoint, "{year}", fmt.Sprintf("%d", year))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; DailyIssueBot/1.0; +https://github.com)")
+
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("holiday year api status: %s", resp.Status)
+	}
+
+	var out struct {
+		Code    int `json:"code"`
+		Holiday map[string]struct {
+			Holiday bool `json:"holiday"`
+		} `json:"holiday"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	if out.Code != 0 {
+		return nil, errors.New("holiday year api returned unknown")
+	}
+
+	result := make(map[string]bool, len(out.Holiday))
+	for dateKey, v := range out.Holiday {
+		result[dateKey] = !v.Holiday
+	}
+	return result, nil
+}
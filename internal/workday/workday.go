@@ -0,0 +1,100 @@
+// Package workday answers "is this date a mainland China workday" with a
+// cache-first, offline-capable resolution order: local cache, then the
+// compiled-in holiday table, then the remote timor.tech API, then finally a
+// plain Mon-Fri weekday heuristic. Keeping the cache and embedded table
+// ahead of the network call means an API outage no longer turns
+// PreviousWorkday's backward walk into ~31 sequential failing requests.
+package workday
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	cacheOnce    sync.Once
+	defaultCache *fileCache
+)
+
+// cache lazily builds defaultCache on first use rather than at package-init
+// time, so WORKDAY_CACHE_FILE is read after main() has loaded .env files
+// instead of whatever was in the process environment before that.
+func cache() *fileCache {
+	cacheOnce.Do(func() {
+		defaultCache = newFileCache(cachePathFromEnv())
+	})
+	return defaultCache
+}
+
+func cachePathFromEnv() string {
+	if p := os.Getenv("WORKDAY_CACHE_FILE"); p != "" {
+		return p
+	}
+	return "state/workday_cache.json"
+}
+
+// IsWorkday reports whether date (interpreted in its own location) is a
+// mainland China workday, resolving in order: cache, embedded table, remote
+// API, weekday heuristic. Answers from the embedded table or the remote API
+// are cached for subsequent calls; the weekday heuristic's answer is not.
+func IsWorkday(ctx context.Context, date time.Time) (bool, error) {
+	dateKey := date.Format("2006-01-02")
+
+	if v, ok := cache().get(dateKey); ok {
+		return v, nil
+	}
+	if v, ok := embeddedTable[dateKey]; ok {
+		cache().set(dateKey, v)
+		return v, nil
+	}
+
+	v, err := fetchRemote(ctx, dateKey)
+	if err == nil {
+		cache().set(dateKey, v)
+		return v, nil
+	}
+
+	// Last resort: plain Mon-Fri heuristic. Not cached, since it's a guess
+	// that should be replaced by a real answer as soon as one is available.
+	weekday := date.Weekday()
+	return weekday != time.Saturday && weekday != time.Sunday, err
+}
+
+// PreviousWorkday walks backward from date (exclusive) to find the most
+// recent mainland China workday, returning it at 00:00 in date's location.
+func PreviousWorkday(ctx context.Context, date time.Time) (time.Time, error) {
+	loc := date.Location()
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+	for i := 1; i <= 31; i++ {
+		candidate := start.AddDate(0, 0, -i)
+		ok, err := IsWorkday(ctx, candidate)
+		if err != nil {
+			if candidate.Weekday() != time.Saturday && candidate.Weekday() != time.Sunday {
+				return candidate, nil
+			}
+			continue
+		}
+		if ok {
+			return candidate, nil
+		}
+	}
+	return start.AddDate(0, 0, -1), nil
+}
+
+// PrefetchYear warms the cache for an entire year in a single remote call,
+// intended to be run once at startup. Later lookups of the dates the year
+// response lists are served from cache; dates it omits (typically plain
+// Mon-Fri workdays) still go through IsWorkday's per-date resolution.
+func PrefetchYear(ctx context.Context, year int) error {
+	results, err := fetchRemoteYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("workday: prefetch year %d: %w", year, err)
+	}
+	for dateKey, v := range results {
+		cache().set(dateKey, v)
+	}
+	return nil
+}
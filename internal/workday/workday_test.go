@@ -0,0 +1,65 @@
+package workday
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestIsWorkdayResolutionOrder exercises IsWorkday's cache-then-embedded
+// precedence against the package-level cache singleton, so it must be the
+// only test in this package to touch it: cache() is guarded by sync.Once,
+// and WORKDAY_CACHE_FILE is only honored on its first call.
+func TestIsWorkdayResolutionOrder(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "workday_cache.json")
+
+	// Seed the cache with a value that contradicts the embedded table for
+	// the same date (2025-01-01 is 元旦, a holiday, in holidays.yaml), to
+	// prove a cache hit wins over the embedded table rather than just
+	// happening to agree with it.
+	seed, err := json.Marshal(map[string]bool{"2025-01-01": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(cachePath, seed, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("WORKDAY_CACHE_FILE", cachePath)
+
+	ctx := context.Background()
+
+	got, err := IsWorkday(ctx, mustDate(t, "2025-01-01"))
+	if err != nil {
+		t.Fatalf("IsWorkday: unexpected error: %v", err)
+	}
+	if !got {
+		t.Error("IsWorkday(2025-01-01) = false, want true from cache (cache should win over the embedded holiday table)")
+	}
+
+	// A date not yet in the cache but present in the embedded table should
+	// resolve from the embedded table without needing the network.
+	got, err = IsWorkday(ctx, mustDate(t, "2025-05-01"))
+	if err != nil {
+		t.Fatalf("IsWorkday: unexpected error: %v", err)
+	}
+	if got {
+		t.Error("IsWorkday(2025-05-01) = true, want false from the embedded 劳动节 entry")
+	}
+
+	// That embedded-table resolution should now have been cached.
+	if v, ok := cache().get("2025-05-01"); !ok || v {
+		t.Errorf("cache().get(2025-05-01) = (%v, %v), want (false, true) after an embedded-table hit", v, ok)
+	}
+}
+
+func mustDate(t *testing.T, s string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
@@ -5,13 +5,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/zhengjinwei-ola/daily_issue/internal/admin"
+	"github.com/zhengjinwei-ola/daily_issue/internal/issuetemplate"
+	"github.com/zhengjinwei-ola/daily_issue/internal/notify"
+	"github.com/zhengjinwei-ola/daily_issue/internal/reportsource"
+	"github.com/zhengjinwei-ola/daily_issue/internal/scheduler"
+	"github.com/zhengjinwei-ola/daily_issue/internal/workday"
 )
 
 // CLI usage (env vars):
@@ -21,9 +28,43 @@ import (
 //	GITHUB_REPO        required: repository name
 //	TIMEZONE           optional: IANA TZ like "Asia/Shanghai" (default)
 //	TITLE_PREFIX       optional: default "项目日报"
-//	SLACK_WEBHOOK_URL  optional: Slack Incoming Webhook to notify when issue created
 //	RUN_LOG_FILE       optional: path to append daily run result logs (default logs/daily_run.log)
+//	SLACK_WEBHOOK_URL, LARK_WEBHOOK_URL, WECOM_WEBHOOK_URL, DISCORD_WEBHOOK_URL
+//	                   optional: enable the matching notification channel
+//	WEBHOOK_URL        optional: generic webhook channel; WEBHOOK_TEMPLATE is a Go text/template
+//	                   for the request body (defaults to a small JSON object)
+//	SMTP_HOST, SMTP_PORT, SMTP_USERNAME, SMTP_PASSWORD, SMTP_FROM, SMTP_TO
+//	                   optional: enable the email channel
+//	NOTIFY_ON          optional: comma-separated event kinds to notify on, from
+//	                   created,exists,error,skipped (default: created)
+//	TITLE_TEMPLATE, BODY_TEMPLATE
+//	                   optional: Go text/template strings for the issue title/body, with
+//	                   {{.Date}} {{.Weekday}} {{.PrevWorkday}} {{.Author}} {{.TitlePrefix}} {{.Items}}.
+//	                   BODY_TEMPLATE defaults to the repo's .github/ISSUE_TEMPLATE/daily.yml issue
+//	                   form body, if present, else the static bullet template.
+//	ISSUE_LABELS, ISSUE_ASSIGNEES, ISSUE_PROJECTS
+//	                   optional: comma-separated; ISSUE_PROJECTS takes ProjectsV2 node IDs
+//	ISSUE_MILESTONE    optional: milestone number to attach
+//	ADMIN_LISTEN       optional: address (e.g. ":15005") to expose the admin HTTP API on;
+//	                   unset means the API is disabled. Requires ADMIN_JWT_SECRET.
+//	ADMIN_JWT_SECRET   required if ADMIN_LISTEN is set: HS256 signing key for admin API bearer tokens
+//	REPORT_SOURCES     optional: comma-separated report sources to collect from the previous
+//	                   workday ("github,gitlog,calendar,ticket"); unset means use the blank
+//	                   template. See internal/reportsource for per-source env vars.
+//	REPORT_SOURCES_CONFIG  optional: path to a YAML file overriding report source settings
+//	SCHEDULE_CRON      optional: one or more cron expressions, comma-separated (default "0 10 * * *")
+//	SCHEDULE_JITTER    optional: max random delay after each computed fire time, e.g. "5m" (default 0)
+//	SCHEDULE_STATE_FILE  optional: path persisting last-run times for catch-up (default state/schedule.json)
+//	WORKDAY_CACHE_FILE  optional: path caching resolved workday lookups (default state/workday_cache.json)
+//	CHINA_WORKDAY_API   optional: override the per-date holiday API URL (use "{date}" as a placeholder)
+//	CHINA_WORKDAY_YEAR_API  optional: override the whole-year holiday API URL (use "{year}" as a placeholder)
+//
+// The --run-once flag runs a single report immediately and exits, for cron/systemd-driven
+// deployments that don't want the in-process scheduling loop.
 func main() {
+	runOnceFlag := flag.Bool("run-once", false, "run a single report immediately and exit, instead of looping on a schedule")
+	flag.Parse()
+
 	ctx := context.Background()
 	loadDotEnvFiles()
 
@@ -45,41 +86,126 @@ func main() {
 		titlePrefix = "服务端个人日报"
 	}
 
-	// Run every day at 10:00 in UTC+8 (Asia/Shanghai)
-	scheduleLoc, _ := time.LoadLocation("Asia/Shanghai")
-	for {
-		now := time.Now().In(scheduleLoc)
-		next := time.Date(now.Year(), now.Month(), now.Day(), 10, 0, 0, 0, scheduleLoc)
-		if !now.Before(next) {
-			// If it's already 10:00 or later, schedule for tomorrow
-			tomorrow := now.AddDate(0, 0, 1)
-			next = time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, scheduleLoc)
+	broadcaster, err := notify.BroadcasterFromEnv()
+	if err != nil {
+		fmt.Println("error: invalid notify config:", err)
+		os.Exit(1)
+	}
+
+	if cnLoc, err := time.LoadLocation("Asia/Shanghai"); err == nil {
+		if err := workday.PrefetchYear(ctx, time.Now().In(cnLoc).Year()); err != nil {
+			fmt.Println("warning: workday prefetch failed, falling back to per-date lookups:", err)
 		}
-		sleep := time.Until(next)
-		fmt.Printf("waiting until %s (UTC+8) to run...\n", next.Format(time.RFC3339))
-		time.Sleep(sleep)
+	}
 
-		issueURL, created, err := createDailyReportIssue(ctx, token, owner, repo, tzName, titlePrefix)
-		if err != nil {
-			fmt.Println("error:", err)
-			_ = appendRunLog(getRunLogPath(), fmt.Sprintf("ERROR %s: %v", time.Now().Format(time.RFC3339), err))
-			continue
+	if *runOnceFlag {
+		if err := runReport(ctx, token, owner, repo, tzName, titlePrefix, broadcaster); err != nil {
+			os.Exit(1)
 		}
+		return
+	}
 
-		if created {
-			fmt.Println("created:", issueURL)
-			_ = appendRunLog(getRunLogPath(), fmt.Sprintf("CREATED %s: %s", time.Now().Format(time.RFC3339), issueURL))
-			if webhook := strings.TrimSpace(os.Getenv("SLACK_WEBHOOK_URL")); webhook != "" {
-				_ = notifySlack(webhook, fmt.Sprintf("今日日报已创建：%s", issueURL))
-			}
-		} else if issueURL == "" {
-			fmt.Println("skipped: not a China mainland workday")
-			_ = appendRunLog(getRunLogPath(), fmt.Sprintf("SKIPPED %s: not a China mainland workday", time.Now().Format(time.RFC3339)))
-		} else {
-			fmt.Println("exists:", issueURL)
-			_ = appendRunLog(getRunLogPath(), fmt.Sprintf("EXISTS %s: %s", time.Now().Format(time.RFC3339), issueURL))
+	if listenAddr := strings.TrimSpace(os.Getenv("ADMIN_LISTEN")); listenAddr != "" {
+		if err := startAdminServer(listenAddr, token, owner, repo, tzName, titlePrefix, broadcaster); err != nil {
+			fmt.Println("error: admin server:", err)
+			os.Exit(1)
 		}
 	}
+
+	scheduleLoc, _ := time.LoadLocation("Asia/Shanghai")
+	var cronExprs []string
+	for _, expr := range strings.Split(envOrDefault("SCHEDULE_CRON", "0 10 * * *"), ",") {
+		if expr = strings.TrimSpace(expr); expr != "" {
+			cronExprs = append(cronExprs, expr)
+		}
+	}
+	jitter, _ := time.ParseDuration(os.Getenv("SCHEDULE_JITTER"))
+	statePath := envOrDefault("SCHEDULE_STATE_FILE", filepath.Join("state", "schedule.json"))
+
+	sched, err := scheduler.New(cronExprs, jitter, statePath)
+	if err != nil {
+		fmt.Println("error: invalid schedule:", err)
+		os.Exit(1)
+	}
+
+	err = sched.Run(ctx, scheduleLoc, func(ctx context.Context, scheduledFor time.Time) error {
+		return runReport(ctx, token, owner, repo, tzName, titlePrefix, broadcaster)
+	})
+	if err != nil {
+		fmt.Println("scheduler stopped:", err)
+		os.Exit(1)
+	}
+}
+
+// startAdminServer launches the opt-in admin HTTP API in the background.
+// Requires ADMIN_JWT_SECRET so every route (besides /healthz) is
+// authenticated; the daemon refuses to start without it.
+func startAdminServer(listenAddr, token, owner, repo, tzName, titlePrefix string, broadcaster *notify.Broadcaster) error {
+	secret := strings.TrimSpace(os.Getenv("ADMIN_JWT_SECRET"))
+	if secret == "" {
+		return fmt.Errorf("ADMIN_LISTEN is set but ADMIN_JWT_SECRET is empty")
+	}
+
+	srv := admin.New(listenAddr, secret, admin.Dependencies{
+		Run: func(ctx context.Context, date *time.Time, force bool) (string, bool, error) {
+			return triggerRun(ctx, token, owner, repo, tzName, titlePrefix, broadcaster, force, date)
+		},
+		RunLogPath: getRunLogPath,
+		IsWorkday:  workday.IsWorkday,
+	})
+
+	go func() {
+		fmt.Println("admin API listening on", listenAddr)
+		if err := srv.ListenAndServe(); err != nil {
+			fmt.Println("admin API stopped:", err)
+		}
+	}()
+	return nil
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// runReport runs a normal, schedule-driven report (no force, no date
+// override) and returns triggerRun's error so the scheduler only marks the
+// schedule as successfully run when it actually was — a failed run must stay
+// eligible for catch-up instead of being silently marked done.
+func runReport(ctx context.Context, token, owner, repo, tzName, titlePrefix string, broadcaster *notify.Broadcaster) error {
+	_, _, err := triggerRun(ctx, token, owner, repo, tzName, titlePrefix, broadcaster, false, nil)
+	return err
+}
+
+// triggerRun creates (or finds) the daily report issue, broadcasts the
+// outcome to every configured notification channel, and appends a result
+// line to the run log. It is the shared path for both the scheduler loop
+// and the admin API's POST /v1/runs.
+func triggerRun(ctx context.Context, token, owner, repo, tzName, titlePrefix string, broadcaster *notify.Broadcaster, force bool, dateOverride *time.Time) (string, bool, error) {
+	issueURL, created, err := createDailyReportIssue(ctx, token, owner, repo, tzName, titlePrefix, force, dateOverride)
+	if err != nil {
+		fmt.Println("error:", err)
+		_ = appendRunLog(getRunLogPath(), fmt.Sprintf("ERROR %s: %v", time.Now().Format(time.RFC3339), err))
+		_ = broadcaster.Broadcast(ctx, notify.Event{Kind: notify.KindError, Err: err})
+		return "", false, err
+	}
+
+	if created {
+		fmt.Println("created:", issueURL)
+		_ = appendRunLog(getRunLogPath(), fmt.Sprintf("CREATED %s: %s", time.Now().Format(time.RFC3339), issueURL))
+		_ = broadcaster.Broadcast(ctx, notify.Event{Kind: notify.KindCreated, IssueURL: issueURL})
+	} else if issueURL == "" {
+		fmt.Println("skipped: not a China mainland workday")
+		_ = appendRunLog(getRunLogPath(), fmt.Sprintf("SKIPPED %s: not a China mainland workday", time.Now().Format(time.RFC3339)))
+		_ = broadcaster.Broadcast(ctx, notify.Event{Kind: notify.KindSkipped})
+	} else {
+		fmt.Println("exists:", issueURL)
+		_ = appendRunLog(getRunLogPath(), fmt.Sprintf("EXISTS %s: %s", time.Now().Format(time.RFC3339), issueURL))
+		_ = broadcaster.Broadcast(ctx, notify.Event{Kind: notify.KindExists, IssueURL: issueURL})
+	}
+	return issueURL, created, nil
 }
 
 // GetStartOfDayUnixByOffsetX10 returns the Unix timestamp (seconds) of 00:00 at
@@ -123,33 +249,60 @@ func GetStartOfDayUnixByOffsetX10(timestamp int64, offsetX10 int) (int64, error)
 
 // createDailyReportIssue creates or finds today's daily report issue in the given repo.
 // It is idempotent: if an open issue with the same title exists, it returns its URL and created=false.
-func createDailyReportIssue(ctx context.Context, token, owner, repo, tzName, titlePrefix string) (string, bool, error) {
+//
+// If force is true, the workday check is skipped entirely: the report date is
+// dateOverride if given, otherwise today. This lets the admin API trigger an
+// ad-hoc run on a weekend or holiday. Without force, a non-nil dateOverride
+// replaces the previous workday as the report date once the workday check
+// passes.
+func createDailyReportIssue(ctx context.Context, token, owner, repo, tzName, titlePrefix string, force bool, dateOverride *time.Time) (string, bool, error) {
 	loc, err := time.LoadLocation(tzName)
 	if err != nil {
 		// fallback to UTC+8 if tz not found
 		loc = time.FixedZone("UTC+08:00", 8*3600)
 	}
 	now := time.Now().In(loc)
-
-	// China mainland workday check using Asia/Shanghai calendar (includes public holidays & make-up days)
 	cnLoc, _ := time.LoadLocation("Asia/Shanghai")
-	workday, err := isChinaWorkday(ctx, now.In(cnLoc))
-	if err != nil {
-		// Fallback: Mon-Fri are workdays if API unavailable
-		if now.In(cnLoc).Weekday() == time.Saturday || now.In(cnLoc).Weekday() == time.Sunday {
-			return "", false, nil // skip
+
+	var prevCN time.Time
+	if force {
+		d := now.In(cnLoc)
+		if dateOverride != nil {
+			d = dateOverride.In(cnLoc)
+		}
+		prevCN = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, cnLoc)
+	} else {
+		// China mainland workday check using Asia/Shanghai calendar (includes public holidays & make-up days)
+		isWorkday, err := workday.IsWorkday(ctx, now.In(cnLoc))
+		if err != nil {
+			// Fallback: Mon-Fri are workdays if API unavailable
+			if now.In(cnLoc).Weekday() == time.Saturday || now.In(cnLoc).Weekday() == time.Sunday {
+				return "", false, nil // skip
+			}
+		} else if !isWorkday {
+			return "", false, nil // skip on non-workday
+		}
+		if dateOverride != nil {
+			d := dateOverride.In(cnLoc)
+			prevCN = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, cnLoc)
+		} else {
+			// Use previous China workday as the report date
+			prevCN, err = workday.PreviousWorkday(ctx, now.In(cnLoc))
+			if err != nil {
+				return "", false, err
+			}
 		}
-	} else if !workday {
-		return "", false, nil // skip on non-workday
 	}
-	// Use previous China workday as the report date
-	prevCN, err := getPreviousChinaWorkday(ctx, now.In(cnLoc))
+
+	templates, err := issuetemplate.Load(ctx, token, owner, repo)
+	if err != nil {
+		return "", false, err
+	}
+	data := buildTemplateData(ctx, token, owner, now, prevCN, titlePrefix)
+	title, body, err := templates.Render(data)
 	if err != nil {
 		return "", false, err
 	}
-	y, m, d := prevCN.Date()
-	dateStr := fmt.Sprintf("【%04d-%02d-%02d】", y, int(m), d)
-	title := fmt.Sprintf("%s %s", dateStr, titlePrefix)
 
 	issueURL, exists, err := findExistingIssue(ctx, token, owner, repo, title)
 	if err != nil {
@@ -159,20 +312,47 @@ func createDailyReportIssue(ctx context.Context, token, owner, repo, tzName, tit
 		return issueURL, false, nil
 	}
 
-	body := strings.Join([]string{
-		"请在此填写：",
-		"- 昨日进展：",
-		"- 今日计划：",
-		"- 风险/阻塞：",
-	}, "\n")
-
-	url, err := createIssue(ctx, token, owner, repo, title, body)
+	opts := issuetemplate.IssueOptionsFromEnv()
+	url, nodeID, err := createIssue(ctx, token, owner, repo, title, body, opts)
 	if err != nil {
 		return "", false, err
 	}
+	if len(opts.Projects) > 0 {
+		addToProjects(ctx, token, nodeID, opts.Projects)
+	}
 	return url, true, nil
 }
 
+// buildTemplateData collects ReportSource items over the previous workday's
+// window [prevWorkday, prevWorkday+24h) and assembles the data made
+// available to TITLE_TEMPLATE/BODY_TEMPLATE. If no sources are enabled (the
+// common case out of the box), Items is left empty and the default body
+// template's static bullet list is used as-is.
+func buildTemplateData(ctx context.Context, token, owner string, now, prevWorkday time.Time, titlePrefix string) issuetemplate.TemplateData {
+	cfg := reportsource.ConfigFromEnv(token, owner)
+	var items []reportsource.ReportItem
+	if sources := cfg.Build(); len(sources) > 0 {
+		from := prevWorkday
+		to := prevWorkday.AddDate(0, 0, 1)
+		items = reportsource.Collect(ctx, sources, from, to, cfg.Timeout)
+	}
+
+	y, m, d := prevWorkday.Date()
+	return issuetemplate.TemplateData{
+		Date:        now.Format("2006-01-02"),
+		Weekday:     chineseWeekday(now.Weekday()),
+		PrevWorkday: fmt.Sprintf("%04d-%02d-%02d", y, int(m), d),
+		Author:      strings.TrimSpace(os.Getenv("REPORT_GITHUB_USER")),
+		TitlePrefix: titlePrefix,
+		Items:       items,
+	}
+}
+
+func chineseWeekday(w time.Weekday) string {
+	names := [...]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"}
+	return names[w]
+}
+
 func findExistingIssue(ctx context.Context, token, owner, repo, title string) (string, bool, error) {
 	endpoint := fmt.Sprintf("https://api.github.com/repos/%s/%s/issues?state=open&per_page=100", owner, repo)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
@@ -204,128 +384,105 @@ func findExistingIssue(ctx context.Context, token, owner, repo, title string) (s
 	return "", false, nil
 }
 
-func createIssue(ctx context.Context, token, owner, repo, title, body string) (string, error) {
+// createIssue creates the issue and returns its URL and GraphQL node ID (the
+// latter needed to add it to a ProjectsV2 board afterwards).
+func createIssue(ctx context.Context, token, owner, repo, title, body string, opts issuetemplate.IssueOptions) (string, string, error) {
 	endpoint := fmt.Sprintf("https://api.github.com/repos/%s/%s/issues", owner, repo)
 	payload := map[string]any{
 		"title": title,
 		"body":  body,
 	}
+	if len(opts.Labels) > 0 {
+		payload["labels"] = opts.Labels
+	}
+	if len(opts.Assignees) > 0 {
+		payload["assignees"] = opts.Assignees
+	}
+	if opts.Milestone > 0 {
+		payload["milestone"] = opts.Milestone
+	}
 	b, _ := json.Marshal(payload)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(b))
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	setGitHubHeaders(req, token)
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("create issue failed: %s", resp.Status)
+		return "", "", fmt.Errorf("create issue failed: %s", resp.Status)
 	}
 	var out struct {
 		HTMLURL string `json:"html_url"`
+		NodeID  string `json:"node_id"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return "", err
+		return "", "", err
 	}
-	return out.HTMLURL, nil
+	return out.HTMLURL, out.NodeID, nil
 }
 
-func setGitHubHeaders(req *http.Request, token string) {
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/vnd.github+json")
-	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+// addToProjects best-effort adds the issue to each ProjectsV2 board in
+// projectIDs (GraphQL node IDs, as found in a project's URL/settings). A
+// failure to add to one project is logged and does not affect the others or
+// the already-created issue.
+func addToProjects(ctx context.Context, token, issueNodeID string, projectIDs []string) {
+	for _, projectID := range projectIDs {
+		if err := addProjectV2Item(ctx, token, projectID, issueNodeID); err != nil {
+			fmt.Printf("warning: failed to add issue to project %s: %v\n", projectID, err)
+		}
+	}
 }
 
-func notifySlack(webhookURL, text string) error {
-	payload := map[string]any{"text": text}
+func addProjectV2Item(ctx context.Context, token, projectID, contentID string) error {
+	query := `mutation($project: ID!, $content: ID!) {
+		addProjectV2ItemById(input: {projectId: $project, contentId: $content}) {
+			item { id }
+		}
+	}`
+	payload := map[string]any{
+		"query":     query,
+		"variables": map[string]any{"project": projectID, "content": contentID},
+	}
 	b, _ := json.Marshal(payload)
-	req, err := http.NewRequest(http.MethodPost, webhookURL, bytes.NewReader(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.github.com/graphql", bytes.NewReader(b))
 	if err != nil {
 		return err
 	}
+	setGitHubHeaders(req, token)
 	req.Header.Set("Content-Type", "application/json")
+
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("slack webhook failed: %s", resp.Status)
-	}
-	return nil
-}
-
-// isChinaWorkday checks whether the given date (interpreted in Asia/Shanghai) is a mainland China workday.
-// It uses timor.tech public holiday API which includes public holidays and make-up working days.
-// If the API is unreachable or returns unknown, an error is returned and caller may fall back.
-func isChinaWorkday(ctx context.Context, dateCN time.Time) (bool, error) {
-	dateStr := dateCN.Format("2006-01-02")
-	endpoint := os.Getenv("CHINA_WORKDAY_API")
-	if endpoint == "" {
-		endpoint = "https://timor.tech/api/holiday/info/" + dateStr
-	} else {
-		endpoint = strings.ReplaceAll(endpoint, "{date}", dateStr)
-	}
-	fmt.Println("endpoint:", endpoint)
-	client := &http.Client{Timeout: 8 * time.Second}
-	var resp *http.Response
-	var err error
-	for attempt := 0; attempt < 3; attempt++ {
-		req, rerr := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-		if rerr != nil {
-			return false, rerr
-		}
-		req.Header.Set("Accept", "application/json")
-		req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; DailyIssueBot/1.0; +https://github.com)")
-		req.Header.Set("Referer", "https://timor.tech/")
-
-		resp, err = client.Do(req)
-		if err != nil {
-			if attempt < 2 {
-				time.Sleep(time.Duration(300*(attempt+1)) * time.Millisecond)
-				continue
-			}
-			return false, err
-		}
-		if resp.StatusCode == http.StatusOK {
-			break
-		}
-		if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
-			resp.Body.Close()
-			if attempt < 2 {
-				time.Sleep(time.Duration(500*(attempt+1)) * time.Millisecond)
-				continue
-			}
-		}
-		defer resp.Body.Close()
-		return false, fmt.Errorf("holiday api status: %s", resp.Status)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("graphql request failed: %s", resp.Status)
 	}
-	defer resp.Body.Close()
 	var out struct {
-		Code int `json:"code"`
-		Type *struct {
-			Type int    `json:"type"` // 0 workday, 1 weekend, 2 holiday
-			Name string `json:"name"`
-		} `json:"type"`
-		Holiday *struct {
-			Holiday bool   `json:"holiday"`
-			Name    string `json:"name"`
-			Wage    int    `json:"wage"`
-			Date    string `json:"date"`
-		} `json:"holiday"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return false, err
+		return err
 	}
-	if out.Code != 0 || out.Type == nil {
-		return false, errors.New("holiday api returned unknown")
+	if len(out.Errors) > 0 {
+		return fmt.Errorf("graphql error: %s", out.Errors[0].Message)
 	}
-	return out.Type.Type == 0, nil
+	return nil
+}
+
+func setGitHubHeaders(req *http.Request, token string) {
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
 }
 
 // getRunLogPath returns the run log path from env RUN_LOG_FILE or defaults to ./logs/daily_run.log
@@ -393,25 +550,3 @@ func loadDotEnvFile(path string) {
 		}
 	}
 }
-
-// getPreviousChinaWorkday walks backwards from the given China time to find the previous workday
-// according to mainland China calendar (including public holidays and make-up days).
-// Returns a date at 00:00 in Asia/Shanghai.
-func getPreviousChinaWorkday(ctx context.Context, dateCN time.Time) (time.Time, error) {
-	cnLoc, _ := time.LoadLocation("Asia/Shanghai")
-	start := time.Date(dateCN.Year(), dateCN.Month(), dateCN.Day(), 0, 0, 0, 0, cnLoc)
-	for i := 1; i <= 31; i++ {
-		candidate := start.AddDate(0, 0, -i)
-		ok, err := isChinaWorkday(ctx, candidate)
-		if err != nil {
-			if candidate.Weekday() != time.Saturday && candidate.Weekday() != time.Sunday {
-				return candidate, nil
-			}
-			continue
-		}
-		if ok {
-			return candidate, nil
-		}
-	}
-	return start.AddDate(0, 0, -1), nil
-}